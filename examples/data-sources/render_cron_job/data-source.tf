@@ -0,0 +1,11 @@
+# Look up by ID
+data "render_cron_job" "by_id" {
+  id = "crn-d3rbus8dl3ps73arh2kg"
+}
+
+# Or look up by name. It is an error for the name to match more than one object.
+data "render_cron_job" "by_name" {
+  name = "my-cron"
+  # Optionally only match within one environment
+  environment_id = "evm-d3rbus8dl3ps73arh2kg"
+}
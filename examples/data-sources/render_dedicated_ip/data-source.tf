@@ -1,7 +1,13 @@
+# Look up by ID
 data "render_dedicated_ip" "outbound" {
   id = "egs-abc123"
 }
 
+# Or look up by name. It is an error for the name to match more than one object.
+data "render_dedicated_ip" "by_name" {
+  name = "outbound"
+}
+
 output "outbound_ips" {
   value = data.render_dedicated_ip.outbound.ips
 }
@@ -0,0 +1,8 @@
+data "render_env_groups" "production" {
+  environment_ids = ["evm-abc123"]
+}
+
+# Environment groups that no service uses.
+output "unused_env_groups" {
+  value = [for eg in data.render_env_groups.production.env_groups : eg.name if length(eg.service_ids) == 0]
+}
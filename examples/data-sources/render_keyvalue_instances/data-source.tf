@@ -0,0 +1,7 @@
+data "render_keyvalue_instances" "production" {
+  environment_ids = ["evm-abc123"]
+}
+
+output "keyvalue_plans" {
+  value = { for kv in data.render_keyvalue_instances.production.instances : kv.name => kv.plan }
+}
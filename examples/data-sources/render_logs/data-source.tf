@@ -0,0 +1,26 @@
+resource "render_web_service" "api" {
+  name   = "api"
+  plan   = "starter"
+  region = "oregon"
+
+  runtime_source = {
+    image = {
+      image_url = "docker.io/library/nginx"
+    }
+  }
+}
+
+# Warn when the service panicked at any point since this run started.
+check "no_panics" {
+  data "render_logs" "panics" {
+    resource_ids = [render_web_service.api.id]
+    text         = ["panic:*"]
+    start_time   = plantimestamp()
+    limit        = 10
+  }
+
+  assert {
+    condition     = length(data.render_logs.panics.entries) == 0
+    error_message = "Service panicked: ${join("\n", [for e in data.render_logs.panics.entries : "${e.timestamp} ${e.message}"])}"
+  }
+}
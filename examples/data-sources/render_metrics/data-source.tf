@@ -0,0 +1,26 @@
+resource "render_web_service" "api" {
+  name   = "api"
+  plan   = "starter"
+  region = "oregon"
+
+  runtime_source = {
+    image = {
+      image_url = "docker.io/library/nginx"
+    }
+  }
+}
+
+# Warn when p95 latency over the last hour exceeded 500ms.
+check "api_latency" {
+  data "render_metrics" "latency" {
+    resource_ids = [render_web_service.api.id]
+    metric       = "http_latency"
+    quantile     = 0.95
+    start_time   = timeadd(plantimestamp(), "-1h")
+  }
+
+  assert {
+    condition     = alltrue([for s in data.render_metrics.latency.series : coalesce(s.max, 0) < 500])
+    error_message = "p95 latency exceeded 500ms in the last hour."
+  }
+}
@@ -0,0 +1,11 @@
+# Look up by ID
+data "render_postgres" "by_id" {
+  id = "dpg-d3rbus8dl3ps73arh2kg"
+}
+
+# Or look up by name. It is an error for the name to match more than one object.
+data "render_postgres" "by_name" {
+  name = "my-db"
+  # Optionally only match within one environment
+  environment_id = "evm-d3rbus8dl3ps73arh2kg"
+}
@@ -0,0 +1,7 @@
+data "render_postgres_instances" "production" {
+  environment_ids = ["evm-abc123"]
+}
+
+output "postgres_versions" {
+  value = { for db in data.render_postgres_instances.production.instances : db.name => db.version }
+}
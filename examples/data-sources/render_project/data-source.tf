@@ -0,0 +1,9 @@
+# Look up by ID
+data "render_project" "by_id" {
+  id = "prj-d3rbus8dl3ps73arh2kg"
+}
+
+# Or look up by name. It is an error for the name to match more than one object.
+data "render_project" "by_name" {
+  name = "my-project"
+}
@@ -0,0 +1,5 @@
+data "render_projects" "all" {}
+
+output "project_environments" {
+  value = { for p in data.render_projects.all.projects : p.name => p.environment_ids }
+}
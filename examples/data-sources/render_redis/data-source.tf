@@ -0,0 +1,11 @@
+# Look up by ID
+data "render_redis" "by_id" {
+  id = "red-d3rbus8dl3ps73arh2kg"
+}
+
+# Or look up by name. It is an error for the name to match more than one object.
+data "render_redis" "by_name" {
+  name = "my-cache"
+  # Optionally only match within one environment
+  environment_id = "evm-d3rbus8dl3ps73arh2kg"
+}
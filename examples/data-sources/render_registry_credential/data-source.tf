@@ -0,0 +1,9 @@
+# Look up by ID
+data "render_registry_credential" "by_id" {
+  id = "rgc-d3rbus8dl3ps73arh2kg"
+}
+
+# Or look up by name. It is an error for the name to match more than one object.
+data "render_registry_credential" "by_name" {
+  name = "ghcr"
+}
@@ -0,0 +1,25 @@
+resource "render_web_service" "api" {
+  name   = "api"
+  plan   = "starter"
+  region = "oregon"
+
+  runtime_source = {
+    image = {
+      image_url = "docker.io/library/nginx"
+    }
+  }
+}
+
+# Warn when an instance crashed at any point since this run started.
+check "no_server_failures" {
+  data "render_service_events" "failures" {
+    service_id = render_web_service.api.id
+    types      = ["server_failed"]
+    start_time = plantimestamp()
+  }
+
+  assert {
+    condition     = length(data.render_service_events.failures.events) == 0
+    error_message = "Service instances failed: ${join(", ", [for e in data.render_service_events.failures.events : "${e.timestamp} ${e.reason}"])}"
+  }
+}
@@ -0,0 +1,27 @@
+data "render_services" "production" {
+  environment_ids = ["evm-abc123"]
+}
+
+# Every static site in the environment, keyed by name.
+locals {
+  static_sites = {
+    for s in data.render_services.production.services : s.name => s
+    if s.type == "static_site"
+  }
+}
+
+resource "render_service_header" "csp" {
+  for_each = local.static_sites
+
+  service_id = each.value.id
+  path       = "/*"
+  name       = "Content-Security-Policy"
+  value      = "default-src 'self'"
+}
+
+# Suspended cron jobs created this year.
+data "render_services" "suspended_cron_jobs" {
+  types         = ["cron_job"]
+  suspended     = true
+  created_after = "2025-01-01T00:00:00Z"
+}
@@ -0,0 +1,11 @@
+# Look up by ID
+data "render_static_site" "by_id" {
+  id = "srv-d3rbus8dl3ps73arh2kg"
+}
+
+# Or look up by name. It is an error for the name to match more than one object.
+data "render_static_site" "by_name" {
+  name = "my-site"
+  # Optionally only match within one environment
+  environment_id = "evm-d3rbus8dl3ps73arh2kg"
+}
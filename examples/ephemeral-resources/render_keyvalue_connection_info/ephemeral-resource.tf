@@ -0,0 +1,14 @@
+provider "render" {
+  # Keep Key Value credentials out of state and plan files.
+  omit_connection_info_from_state = true
+}
+
+resource "render_keyvalue" "example" {
+  name   = "example-keyvalue"
+  plan   = "starter"
+  region = "ohio"
+}
+
+ephemeral "render_keyvalue_connection_info" "example" {
+  keyvalue_id = render_keyvalue.example.id
+}
@@ -0,0 +1,16 @@
+provider "render" {
+  # Keep database credentials out of state and plan files.
+  omit_connection_info_from_state = true
+}
+
+resource "render_postgres" "example" {
+  name    = "example-postgres-instance"
+  plan    = "basic_256mb"
+  region  = "ohio"
+  version = "17"
+}
+
+ephemeral "render_postgres_connection_info" "example" {
+  postgres_id = render_postgres.example.id
+}
+
@@ -0,0 +1,15 @@
+locals {
+  db = provider::render::parse_postgres_url(render_postgres.example.connection_info.external_connection_string)
+}
+
+output "read_only_url" {
+  sensitive = true
+  value = provider::render::build_postgres_url(
+    local.db.host,
+    local.db.port,
+    "readonly",
+    var.readonly_password,
+    local.db.database,
+    "require",
+  )
+}
@@ -0,0 +1,24 @@
+locals {
+  blueprint = provider::render::decode_blueprint(file("${path.module}/render.yaml"))
+}
+
+resource "render_web_service" "from_blueprint" {
+  for_each = local.blueprint.web_services
+
+  name              = each.value.name
+  plan              = coalesce(each.value.plan, "starter")
+  region            = coalesce(each.value.region, "oregon")
+  start_command     = each.value.start_command
+  health_check_path = each.value.health_check_path
+  runtime_source    = each.value.runtime_source
+  autoscaling       = each.value.autoscaling
+  disk              = each.value.disk
+  env_vars          = each.value.env_vars
+}
+
+resource "render_env_group" "from_blueprint" {
+  for_each = local.blueprint.env_groups
+
+  name     = each.value.name
+  env_vars = each.value.env_vars
+}
@@ -0,0 +1,10 @@
+resource "render_env_group" "app" {
+  name = "app"
+
+  env_vars = merge(
+    provider::render::dotenv_decode(file("${path.module}/.env")),
+    {
+      SESSION_SECRET = { generate_value = true }
+    },
+  )
+}
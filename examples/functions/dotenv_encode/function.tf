@@ -0,0 +1,12 @@
+resource "render_env_group" "app" {
+  name = "app"
+
+  secret_files = {
+    ".env" = {
+      content = provider::render::dotenv_encode({
+        DATABASE_URL = render_postgres.example.connection_info.internal_connection_string
+        LOG_LEVEL    = "info"
+      })
+    }
+  }
+}
@@ -0,0 +1,15 @@
+locals {
+  db = provider::render::parse_postgres_url(render_postgres.example.connection_info.internal_connection_string)
+}
+
+resource "render_web_service" "legacy" {
+  # ...
+
+  env_vars = {
+    DB_HOST     = { value = local.db.host }
+    DB_PORT     = { value = tostring(local.db.port) }
+    DB_USER     = { value = local.db.user }
+    DB_PASSWORD = { value = local.db.password }
+    DB_NAME     = { value = local.db.database }
+  }
+}
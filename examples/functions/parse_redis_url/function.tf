@@ -0,0 +1,12 @@
+locals {
+  cache = provider::render::parse_redis_url(render_keyvalue.example.connection_info.external_connection_string)
+}
+
+output "cache_tls" {
+  # true for rediss:// URLs
+  value = local.cache.tls
+}
+
+output "cache_address" {
+  value = "${local.cache.host}:${local.cache.port}"
+}
@@ -0,0 +1,10 @@
+# List the Key Value instances in the workspace so that `terraform query` can generate
+# import blocks for them.
+list "render_keyvalue" "all" {
+  provider = render
+
+  config {
+    owner_id = "tea-cmtus5u22nds73amqgkg"
+    environment_ids = ["evm-cph1rs3idesc73a2b2mg"]
+  }
+}
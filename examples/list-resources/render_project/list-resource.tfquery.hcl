@@ -0,0 +1,9 @@
+# List the projects in the workspace so that `terraform query` can generate
+# import blocks for them.
+list "render_project" "all" {
+  provider = render
+
+  config {
+    owner_id = "tea-cmtus5u22nds73amqgkg"
+  }
+}
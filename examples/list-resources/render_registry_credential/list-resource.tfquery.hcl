@@ -0,0 +1,9 @@
+# List the registry credentials in the workspace so that `terraform query` can generate
+# import blocks for them.
+list "render_registry_credential" "all" {
+  provider = render
+
+  config {
+    owner_id = "tea-cmtus5u22nds73amqgkg"
+  }
+}
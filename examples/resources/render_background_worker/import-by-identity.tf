@@ -0,0 +1,6 @@
+import {
+  to = render_background_worker.example
+  identity = {
+    id = "srv-cmtus5u22nds73amqgkg"
+  }
+}
@@ -1,2 +1,8 @@
 # Import this resource using the service ID
-terraform import render_background_worker.resource_name srv-cmtus5u22nds73amqgkg
\ No newline at end of file
+terraform import render_background_worker.resource_name srv-cmtus5u22nds73amqgkg
+
+# Or import it by name
+terraform import render_background_worker.resource_name name:my-worker
+
+# Names only need to be unique within an environment
+terraform import render_background_worker.resource_name env:evm-cph1rs3idesc73a2b2mg/name:my-worker
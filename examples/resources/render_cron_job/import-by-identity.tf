@@ -0,0 +1,6 @@
+import {
+  to = render_cron_job.example
+  identity = {
+    id = "crn-cmtus5u22nds73amqgkg"
+  }
+}
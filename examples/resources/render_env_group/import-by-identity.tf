@@ -0,0 +1,6 @@
+import {
+  to = render_env_group.example
+  identity = {
+    id = "evg-comelc212bfj73aa6550"
+  }
+}
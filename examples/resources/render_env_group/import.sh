@@ -1,2 +1,8 @@
 # Import this resource using the env group ID
-terraform import render_env_group.resource_name evg-comelc212bfj73aa6550
\ No newline at end of file
+terraform import render_env_group.resource_name evg-comelc212bfj73aa6550
+
+# Or import it by name
+terraform import render_env_group.resource_name name:shared-config
+
+# Names only need to be unique within an environment
+terraform import render_env_group.resource_name env:evm-cph1rs3idesc73a2b2mg/name:shared-config
@@ -0,0 +1,2 @@
+# Import this resource using the environment group ID and env var key
+terraform import render_env_group_env_var.resource_name evg-cmtus5u22nds73amqgkg/OTEL_EXPORTER_OTLP_ENDPOINT
@@ -0,0 +1,13 @@
+# A shared group whose keys are added by several workspaces.
+resource "render_env_group" "observability" {
+  name = "observability"
+
+  # Leave keys managed by render_env_group_env_var resources alone.
+  ignore_unmanaged_env_vars = true
+}
+
+resource "render_env_group_env_var" "otel_endpoint" {
+  env_group_id = render_env_group.observability.id
+  key          = "OTEL_EXPORTER_OTLP_ENDPOINT"
+  value        = "https://otel.example.com:4317"
+}
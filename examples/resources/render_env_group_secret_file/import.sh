@@ -0,0 +1,2 @@
+# Import this resource using the environment group ID and secret file name
+terraform import render_env_group_secret_file.resource_name evg-cmtus5u22nds73amqgkg/otel-collector.yaml
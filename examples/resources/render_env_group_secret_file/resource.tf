@@ -0,0 +1,13 @@
+# A shared group whose keys are added by several workspaces.
+resource "render_env_group" "observability" {
+  name = "observability"
+
+  # Leave files managed by render_env_group_secret_file resources alone.
+  ignore_unmanaged_env_vars = true
+}
+
+resource "render_env_group_secret_file" "collector_config" {
+  env_group_id = render_env_group.observability.id
+  name         = "otel-collector.yaml"
+  content      = file("${path.module}/otel-collector.yaml")
+}
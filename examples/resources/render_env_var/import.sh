@@ -0,0 +1,2 @@
+# Import this resource using the service ID and env var key
+terraform import render_env_var.resource_name srv-cmtus5u22nds73amqgkg/DATABASE_URL
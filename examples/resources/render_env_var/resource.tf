@@ -0,0 +1,30 @@
+resource "render_web_service" "api" {
+  name   = "api"
+  plan   = "starter"
+  region = "oregon"
+  runtime_source = {
+    native_runtime = {
+      auto_deploy   = true
+      branch        = "main"
+      build_command = "npm install"
+      repo_url      = "https://github.com/render-examples/express-hello-world"
+      runtime       = "node"
+    }
+  }
+  start_command = "npm start"
+
+  # Leave env vars managed by render_env_var resources alone.
+  ignore_unmanaged_env_vars = true
+}
+
+resource "render_env_var" "feature_flag" {
+  service_id = render_web_service.api.id
+  key        = "FEATURE_FLAG"
+  value      = "enabled"
+}
+
+resource "render_env_var" "session_secret" {
+  service_id     = render_web_service.api.id
+  key            = "SESSION_SECRET"
+  generate_value = true
+}
@@ -0,0 +1,6 @@
+import {
+  to = render_keyvalue.example
+  identity = {
+    id = "red-cmtus5u22nds73amqgkg"
+  }
+}
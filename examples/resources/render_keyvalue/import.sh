@@ -0,0 +1,8 @@
+# Import this resource using the Key Value ID
+terraform import render_keyvalue.resource_name red-cmtus5u22nds73amqgkg
+
+# Or import it by name
+terraform import render_keyvalue.resource_name name:my-cache
+
+# Names only need to be unique within an environment
+terraform import render_keyvalue.resource_name env:evm-cph1rs3idesc73a2b2mg/name:my-cache
@@ -0,0 +1,6 @@
+import {
+  to = render_postgres.example
+  identity = {
+    id = "dpg-cmtus5u22nds73amqgkg"
+  }
+}
@@ -1,2 +1,8 @@
 # Import this resource using the database ID
-terraform import render_postgres.resource_name dpg-cmtus5u22nds73amqgkg
\ No newline at end of file
+terraform import render_postgres.resource_name dpg-cmtus5u22nds73amqgkg
+
+# Or import it by name
+terraform import render_postgres.resource_name name:my-db
+
+# Names only need to be unique within an environment
+terraform import render_postgres.resource_name env:evm-cph1rs3idesc73a2b2mg/name:my-db
@@ -0,0 +1,6 @@
+import {
+  to = render_project.example
+  identity = {
+    id = "prj-cpku2n5qtlos70htpqbg"
+  }
+}
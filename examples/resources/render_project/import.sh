@@ -1,3 +1,6 @@
 # Import a project using the project ID
 # The keys of the environment map must match the names of the environment.
-terraform import render_project.resource_name prj-cpku2n5qtlos70htpqbg
\ No newline at end of file
+terraform import render_project.resource_name prj-cpku2n5qtlos70htpqbg
+
+# Or import it by name
+terraform import render_project.resource_name name:my-project
@@ -0,0 +1,6 @@
+import {
+  to = render_registry_credential.example
+  identity = {
+    id = "rgc-cmtus5u22nds73amqgkg"
+  }
+}
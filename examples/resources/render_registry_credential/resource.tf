@@ -3,4 +3,19 @@ resource "render_registry_credential" "example" {
   registry   = "DOCKER"
   username   = "my-username"
   auth_token = "my-auth-token"
-}
\ No newline at end of file
+}
+
+# Use a write-only token so it is never stored in state. Bump
+# auth_token_wo_version to rotate it.
+ephemeral "vault_kv_secret_v2" "registry" {
+  mount = "secret"
+  name  = "registry"
+}
+
+resource "render_registry_credential" "write_only" {
+  name                  = "my-write-only-registry-credential"
+  registry              = "GITHUB"
+  username              = "my-username"
+  auth_token_wo         = ephemeral.vault_kv_secret_v2.registry.data.token
+  auth_token_wo_version = 1
+}
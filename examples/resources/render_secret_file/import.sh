@@ -0,0 +1,2 @@
+# Import this resource using the service ID and secret file name
+terraform import render_secret_file.resource_name srv-cmtus5u22nds73amqgkg/credentials.json
@@ -0,0 +1,24 @@
+resource "render_web_service" "api" {
+  name   = "api"
+  plan   = "starter"
+  region = "oregon"
+  runtime_source = {
+    native_runtime = {
+      auto_deploy   = true
+      branch        = "main"
+      build_command = "npm install"
+      repo_url      = "https://github.com/render-examples/express-hello-world"
+      runtime       = "node"
+    }
+  }
+  start_command = "npm start"
+
+  # Leave secret files managed by render_secret_file resources alone.
+  ignore_unmanaged_env_vars = true
+}
+
+resource "render_secret_file" "credentials" {
+  service_id = render_web_service.api.id
+  name       = "credentials.json"
+  content    = file("${path.module}/credentials.json")
+}
@@ -0,0 +1,2 @@
+# Import this resource using the service ID and header ID
+terraform import render_service_header.resource_name srv-cmtus5u22nds73amqgkg/hdr-cmtus5u22nds73amqgkh
\ No newline at end of file
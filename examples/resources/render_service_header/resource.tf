@@ -0,0 +1,13 @@
+resource "render_static_site" "docs" {
+  name          = "docs"
+  repo_url      = "https://github.com/render-examples/create-react-app"
+  build_command = "npm run build"
+  publish_path  = "build"
+}
+
+resource "render_service_header" "csp" {
+  service_id = render_static_site.docs.id
+  path       = "/*"
+  name       = "Content-Security-Policy"
+  value      = "default-src 'self'"
+}
@@ -0,0 +1,2 @@
+# Import this resource using the parent service ID and the preview service ID
+terraform import render_service_preview.resource_name srv-cmtus5u22nds73amqgkg/srv-cmtus5u22nds73amqgkh
\ No newline at end of file
@@ -0,0 +1,27 @@
+variable "pr_number" {
+  type = number
+}
+
+resource "render_web_service" "api" {
+  name   = "api"
+  plan   = "starter"
+  region = "oregon"
+
+  runtime_source = {
+    image = {
+      image_url = "ghcr.io/example/api"
+      tag       = "main"
+    }
+  }
+}
+
+# A preview of the API running the image built for a pull request.
+resource "render_service_preview" "pr" {
+  service_id = render_web_service.api.id
+  image_path = "ghcr.io/example/api:pr-${var.pr_number}"
+  name       = "api-pr-${var.pr_number}"
+}
+
+output "preview_url" {
+  value = render_service_preview.pr.url
+}
@@ -0,0 +1,2 @@
+# Import this resource using the service ID and route ID
+terraform import render_service_route.resource_name srv-cmtus5u22nds73amqgkg/rdr-cmtus5u22nds73amqgkh
\ No newline at end of file
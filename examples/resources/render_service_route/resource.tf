@@ -0,0 +1,21 @@
+resource "render_static_site" "docs" {
+  name          = "docs"
+  repo_url      = "https://github.com/render-examples/create-react-app"
+  build_command = "npm run build"
+  publish_path  = "build"
+}
+
+resource "render_service_route" "old_blog" {
+  service_id  = render_static_site.docs.id
+  type        = "redirect"
+  source      = "/blog/*"
+  destination = "https://blog.example.com/*"
+  priority    = 0
+}
+
+resource "render_service_route" "spa" {
+  service_id  = render_static_site.docs.id
+  type        = "rewrite"
+  source      = "/*"
+  destination = "/index.html"
+}
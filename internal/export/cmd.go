@@ -0,0 +1,96 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/time/rate"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider"
+)
+
+type stringsFlag []string
+
+func (f *stringsFlag) String() string { return strings.Join(*f, ",") }
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// Run runs the export subcommand with the arguments that follow "export". The
+// API key is read from RENDER_API_KEY so that it does not end up in shell
+// history.
+func Run(ctx context.Context, version string, args []string, stdout, stderr io.Writer) error {
+	var (
+		opts         Options
+		environments stringsFlag
+		out          string
+	)
+
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: terraform-provider-render export [flags]")
+		_, _ = fmt.Fprintln(stderr, "\nWrites resource and import blocks for an existing Render workspace. Requires RENDER_API_KEY.")
+		_, _ = fmt.Fprintln(stderr, "\nFlags:")
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&opts.OwnerID, "owner", os.Getenv("RENDER_OWNER_ID"), "ID of the workspace to export (defaults to RENDER_OWNER_ID)")
+	fs.Var(&environments, "environment", "only export resources in this environment; may be repeated")
+	fs.BoolVar(&opts.IncludeSensitive, "include-sensitive", false, "write sensitive values such as env var values into the output")
+	fs.StringVar(&out, "out", "", "file to write the configuration to (defaults to stdout)")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	opts.EnvironmentIDs = environments
+
+	apiKey := os.Getenv("RENDER_API_KEY")
+	if apiKey == "" {
+		return errors.New("RENDER_API_KEY must be set")
+	}
+	if opts.OwnerID == "" {
+		return errors.New("an owner is required: pass -owner or set RENDER_OWNER_ID")
+	}
+
+	host := os.Getenv("RENDER_HOST")
+	if host == "" {
+		host = "https://api.render.com/v1"
+	}
+
+	apiClient, err := client.NewClientWithResponses(host,
+		client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+apiKey)
+			req.Header.Set("User-Agent", "terraform-provider-render/"+version)
+			return nil
+		}),
+		client.WithHTTPClient(provider.NewRateLimitHTTPClient(&http.Client{}, rate.NewLimiter(rate.Limit(provider.DefaultRequestsPerMinute/60.0), provider.DefaultRequestBurst), provider.DefaultRetryPolicy, provider.SleepContext)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		return Export(ctx, apiClient, provider.New(version)(), opts, stdout)
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	if err := Export(ctx, apiClient, provider.New(version)(), opts, f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
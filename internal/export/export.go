@@ -0,0 +1,289 @@
+// Package export generates Terraform configuration and import blocks for the
+// resources that already exist in a Render workspace.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-render/internal/client"
+	logstreamresource "terraform-provider-render/internal/provider/logstreams/resource"
+	metricsstreamresource "terraform-provider-render/internal/provider/metricstream/resource"
+	notificationsresource "terraform-provider-render/internal/provider/notifications/resource"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+const providerTypeName = "render"
+
+// Options configures which resources are exported.
+type Options struct {
+	// OwnerID is the workspace to export.
+	OwnerID string
+	// EnvironmentIDs restricts the export to resources in these environments.
+	// Workspace-level resources such as projects, registry credentials and
+	// settings are only exported when it is empty.
+	EnvironmentIDs []string
+	// IncludeSensitive writes sensitive values such as env var values into the
+	// generated configuration instead of leaving them out.
+	IncludeSensitive bool
+}
+
+// settingsResources are workspace settings. They have no list resource and
+// cannot be imported, so applying the exported configuration adopts them.
+var settingsResources = []func() resource.Resource{
+	logstreamresource.NewLogStreamSettingResource,
+	metricsstreamresource.NewMetricsStreamSettingResource,
+	notificationsresource.NewNotificationSettingResource,
+}
+
+// Export writes a resource block and a matching import block for every
+// resource the provider can list in the workspace. Each resource is read with
+// the same code the resource uses on import, so planning the generated
+// configuration shows no changes.
+func Export(ctx context.Context, apiClient *client.ClientWithResponses, p provider.Provider, opts Options, w io.Writer) error {
+	lp, ok := p.(provider.ProviderWithListResources)
+	if !ok {
+		return errors.New("provider does not implement list resources")
+	}
+
+	data := &rendertypes.Data{Client: apiClient, OwnerID: opts.OwnerID}
+	e := &exporter{opts: opts, file: hclwrite.NewEmptyFile(), names: map[string]int{}}
+
+	for _, newListResource := range lp.ListResources(ctx) {
+		if err := e.exportList(ctx, data, newListResource()); err != nil {
+			return err
+		}
+	}
+
+	if len(opts.EnvironmentIDs) == 0 {
+		for _, newResource := range settingsResources {
+			if err := e.exportSettings(ctx, data, newResource()); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := w.Write(e.file.Bytes())
+	return err
+}
+
+type exporter struct {
+	opts  Options
+	file  *hclwrite.File
+	names map[string]int
+	// omitted lists the sensitive values left out of the resource being
+	// written.
+	omitted []string
+}
+
+func (e *exporter) exportList(ctx context.Context, data *rendertypes.Data, lr list.ListResource) error {
+	r, ok := lr.(resource.Resource)
+	if !ok {
+		return fmt.Errorf("list resource %T is not a managed resource", lr)
+	}
+
+	typeName, resourceSchema, err := configure(ctx, r, data)
+	if err != nil {
+		return err
+	}
+
+	var listSchemaResp list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchemaResp)
+	if err := diagsErr(typeName, listSchemaResp.Diagnostics); err != nil {
+		return err
+	}
+
+	_, envScoped := listSchemaResp.Schema.Attributes["environment_ids"]
+	if len(e.opts.EnvironmentIDs) > 0 && !envScoped {
+		return nil
+	}
+
+	rwi, ok := r.(resource.ResourceWithIdentity)
+	if !ok {
+		return fmt.Errorf("%s does not have a resource identity", typeName)
+	}
+	var identityResp resource.IdentitySchemaResponse
+	rwi.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	config, err := e.listConfig(ctx, listSchemaResp.Schema.Type().TerraformType(ctx), envScoped)
+	if err != nil {
+		return err
+	}
+
+	req := list.ListRequest{
+		Config:                 tfsdk.Config{Schema: listSchemaResp.Schema, Raw: config},
+		IncludeResource:        true,
+		ResourceSchema:         resourceSchema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+	stream := list.ListResultsStream{}
+	lr.List(ctx, req, &stream)
+	if stream.Results == nil {
+		return nil
+	}
+
+	for result := range stream.Results {
+		if err := diagsErr(typeName, result.Diagnostics); err != nil {
+			return err
+		}
+
+		// Resources that disappear between listing and reading are skipped.
+		if result.Resource == nil || result.Resource.Raw.IsNull() {
+			continue
+		}
+
+		id, err := identityID(ctx, result.Identity)
+		if err != nil {
+			return fmt.Errorf("%s: %w", typeName, err)
+		}
+
+		label := e.label(typeName, result.DisplayName)
+		e.writeImport(typeName, label, id)
+		if err := e.writeResource(typeName, label, resourceSchema, result.Resource.Raw); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) exportSettings(ctx context.Context, data *rendertypes.Data, r resource.Resource) error {
+	typeName, resourceSchema, err := configure(ctx, r, data)
+	if err != nil {
+		return err
+	}
+
+	// The settings are read the same way as after a create, from a state that
+	// has no values yet.
+	raw, err := emptyObject(resourceSchema.Type().TerraformType(ctx))
+	if err != nil {
+		return err
+	}
+
+	state := tfsdk.State{Schema: resourceSchema, Raw: raw}
+	readResp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	if err := diagsErr(typeName, readResp.Diagnostics); err != nil {
+		return err
+	}
+	if readResp.State.Raw.IsNull() {
+		return nil
+	}
+
+	return e.writeResource(typeName, e.label(typeName, "settings"), resourceSchema, readResp.State.Raw)
+}
+
+// listConfig builds the config of a list block filtering on the exported owner
+// and environments.
+func (e *exporter) listConfig(ctx context.Context, typ tftypes.Type, envScoped bool) (tftypes.Value, error) {
+	objectType, ok := typ.(tftypes.Object)
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("unexpected list config type %s", typ)
+	}
+
+	values := nullAttributes(objectType)
+	values["owner_id"] = tftypes.NewValue(tftypes.String, e.opts.OwnerID)
+	if envScoped && len(e.opts.EnvironmentIDs) > 0 {
+		var ids []tftypes.Value
+		for _, id := range e.opts.EnvironmentIDs {
+			ids = append(ids, tftypes.NewValue(tftypes.String, id))
+		}
+		values["environment_ids"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, ids)
+	}
+
+	return tftypes.NewValue(objectType, values), nil
+}
+
+// emptyObject returns an object of typ whose attributes are all null.
+func emptyObject(typ tftypes.Type) (tftypes.Value, error) {
+	objectType, ok := typ.(tftypes.Object)
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("unexpected schema type %s", typ)
+	}
+	return tftypes.NewValue(objectType, nullAttributes(objectType)), nil
+}
+
+func nullAttributes(objectType tftypes.Object) map[string]tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	return values
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// label derives a unique resource name from the display name of a resource.
+func (e *exporter) label(typeName, displayName string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "r_" + label
+	}
+
+	key := typeName + "." + label
+	e.names[key]++
+	if n := e.names[key]; n > 1 {
+		return fmt.Sprintf("%s_%d", label, n)
+	}
+	return label
+}
+
+func configure(ctx context.Context, r resource.Resource, data *rendertypes.Data) (string, schema.Schema, error) {
+	var metadataResp resource.MetadataResponse
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResp)
+	typeName := metadataResp.TypeName
+
+	if rc, ok := r.(resource.ResourceWithConfigure); ok {
+		var configureResp resource.ConfigureResponse
+		rc.Configure(ctx, resource.ConfigureRequest{ProviderData: data}, &configureResp)
+		if err := diagsErr(typeName, configureResp.Diagnostics); err != nil {
+			return "", schema.Schema{}, err
+		}
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if err := diagsErr(typeName, schemaResp.Diagnostics); err != nil {
+		return "", schema.Schema{}, err
+	}
+
+	return typeName, schemaResp.Schema, nil
+}
+
+func identityID(ctx context.Context, identity *tfsdk.ResourceIdentity) (string, error) {
+	if identity == nil {
+		return "", errors.New("missing resource identity")
+	}
+
+	var id string
+	if diags := identity.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		return "", diagsErr("identity", diags)
+	}
+	return id, nil
+}
+
+func diagsErr(typeName string, diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+
+	var msgs []string
+	for _, d := range diags.Errors() {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return fmt.Errorf("%s: %s", typeName, strings.Join(msgs, "; "))
+}
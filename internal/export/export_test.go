@@ -0,0 +1,223 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/export"
+	"terraform-provider-render/internal/provider"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func TestExport(t *testing.T) {
+	var envGroupQuery string
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/services": func(resp http.ResponseWriter, req *http.Request) {
+			if req.URL.Query().Get("type") != "static_site" {
+				th.StaticResponse(`[]`)(resp, req)
+				return
+			}
+			th.StaticResponse(`[{"cursor": "c1", "service": {"id": "srv-123", "name": "Docs", "type": "static_site"}}]`)(resp, req)
+		},
+		"/services/srv-123": th.StaticResponse(`{
+			"id": "srv-123",
+			"name": "Docs",
+			"slug": "docs",
+			"ownerId": "own-123",
+			"type": "static_site",
+			"autoDeploy": "yes",
+			"repo": "https://github.com/render-examples/docs",
+			"branch": "main",
+			"rootDir": "",
+			"serviceDetails": {"buildCommand": "npm run build", "publishPath": "dist", "url": "https://docs.onrender.com"}
+		}`),
+		"/services/srv-123/env-vars":                        th.StaticResponse(`[]`),
+		"/services/srv-123/secret-files":                    th.StaticResponse(`[]`),
+		"/services/srv-123/custom-domains":                  th.StaticResponse(`[]`),
+		"/services/srv-123/headers":                         th.StaticResponse(`[{"cursor": "c1", "header": {"id": "hdr-123", "path": "/*", "name": "X-Frame-Options", "value": "DENY"}}]`),
+		"/services/srv-123/routes":                          th.StaticResponse(`[{"cursor": "c1", "route": {"id": "rdr-123", "type": "rewrite", "source": "/*", "destination": "/index.html", "priority": 0}}]`),
+		"/notification-settings/overrides/services/srv-123": th.StaticResponse(`{"serviceId": "srv-123", "notificationsToSend": "default", "previewNotificationsEnabled": "default"}`),
+		"/postgres":            th.StaticResponse(`[]`),
+		"/key-value":           th.StaticResponse(`[]`),
+		"/registrycredentials": th.StaticResponse(`[]`),
+		"/env-groups": func(resp http.ResponseWriter, req *http.Request) {
+			envGroupQuery = req.URL.RawQuery
+			th.StaticResponse(`[{"id": "evg-123", "name": "Shared Config", "ownerId": "own-123", "environmentId": "evm-123", "serviceLinks": []}]`)(resp, req)
+		},
+		"/env-groups/evg-123": th.StaticResponse(`{
+			"id": "evg-123",
+			"name": "Shared Config",
+			"ownerId": "own-123",
+			"environmentId": "evm-123",
+			"envVars": [{"key": "LOG_LEVEL", "value": "debug"}],
+			"secretFiles": [{"name": "config.json", "content": "{}"}],
+			"serviceLinks": []
+		}`),
+		"/projects":         th.StaticResponse(`[{"cursor": "c1", "project": {"id": "prj-123", "name": "My App", "environmentIds": ["evm-123"]}}]`),
+		"/projects/prj-123": th.StaticResponse(`{"id": "prj-123", "name": "My App", "environmentIds": ["evm-123"]}`),
+		"/environments/evm-123": th.StaticResponse(`{
+			"id": "evm-123",
+			"name": "production",
+			"projectId": "prj-123",
+			"protectedStatus": "unprotected",
+			"networkIsolationEnabled": false,
+			"serviceIds": [],
+			"databasesIds": [],
+			"redisIds": [],
+			"envGroupIds": []
+		}`),
+	})
+	defer mockAPI.Close()
+
+	c, err := client.NewClientWithResponses(mockAPI.URL)
+	require.NoError(t, err)
+
+	t.Run("it writes resource and import blocks", func(t *testing.T) {
+		var out bytes.Buffer
+		err := export.Export(context.Background(), c, provider.New("test")(), export.Options{OwnerID: "own-123"}, &out)
+		require.NoError(t, err)
+
+		hcl := out.String()
+		assert.Contains(t, hcl, "import {\n  to = render_env_group.shared_config\n  id = \"evg-123\"\n}")
+		assert.Contains(t, hcl, `resource "render_env_group" "shared_config" {`)
+		assert.Contains(t, hcl, `name           = "Shared Config"`)
+		assert.Contains(t, hcl, `environment_id = "evm-123"`)
+		assert.Contains(t, hcl, "import {\n  to = render_project.my_app\n  id = \"prj-123\"\n}")
+		assert.Contains(t, hcl, `resource "render_project" "my_app" {`)
+		assert.Contains(t, hcl, `name             = "production"`)
+		assert.Contains(t, hcl, "import {\n  to = render_static_site.docs\n  id = \"srv-123\"\n}")
+		assert.Contains(t, hcl, `destination = "/index.html"`, "routes of imported static sites are written")
+		assert.Contains(t, hcl, `value = "DENY"`, "headers of imported static sites are written")
+		assert.NotContains(t, hcl, `"debug"`, "sensitive values are left out by default")
+		assert.NotContains(t, hcl, "LOG_LEVEL = {", "env vars without their value are left out")
+		assert.Contains(t, hcl, "  #   env_vars[\"LOG_LEVEL\"]\n  #   secret_files[\"config.json\"]\n")
+		assert.NotContains(t, hcl, "evg-123\"\n  name", "computed ids are not written")
+		validateConfig(t, out.Bytes())
+	})
+
+	t.Run("it includes sensitive values on request", func(t *testing.T) {
+		var out bytes.Buffer
+		err := export.Export(context.Background(), c, provider.New("test")(), export.Options{OwnerID: "own-123", IncludeSensitive: true}, &out)
+		require.NoError(t, err)
+
+		assert.Contains(t, out.String(), `value = "debug"`)
+		assert.NotContains(t, out.String(), "Sensitive values were left out")
+		validateConfig(t, out.Bytes())
+	})
+
+	t.Run("it only exports environment resources when filtering by environment", func(t *testing.T) {
+		var out bytes.Buffer
+		err := export.Export(context.Background(), c, provider.New("test")(), export.Options{OwnerID: "own-123", EnvironmentIDs: []string{"evm-123"}}, &out)
+		require.NoError(t, err)
+
+		assert.Contains(t, envGroupQuery, "environmentId=evm-123")
+		assert.Contains(t, out.String(), "render_env_group")
+		assert.NotContains(t, out.String(), "render_project")
+	})
+}
+
+// validateConfig checks every resource block of an exported configuration
+// against the validation of its resource schema.
+func validateConfig(t *testing.T, src []byte) {
+	t.Helper()
+	ctx := context.Background()
+
+	file, diags := hclsyntax.ParseConfig(src, "export.tf", hcl.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	server := providerserver.NewProtocol6(provider.New("test")())()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type != "resource" {
+			continue
+		}
+		typeName := block.Labels[0]
+		resourceSchema, ok := schemaResp.ResourceSchemas[typeName]
+		require.True(t, ok, typeName)
+
+		attrs := map[string]cty.Value{}
+		for name, attr := range block.Body.Attributes {
+			value, diags := attr.Expr.Value(nil)
+			require.False(t, diags.HasErrors(), diags.Error())
+			attrs[name] = value
+		}
+
+		typ := resourceSchema.ValueType()
+		config, err := tfprotov6.NewDynamicValue(typ, configValue(t, cty.ObjectVal(attrs), typ))
+		require.NoError(t, err)
+
+		resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+			TypeName: typeName,
+			Config:   &config,
+		})
+		require.NoError(t, err)
+		for _, d := range resp.Diagnostics {
+			assert.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity, "%s.%s: %s: %s", typeName, block.Labels[1], d.Summary, d.Detail)
+		}
+	}
+}
+
+// configValue converts a value written to the configuration into the type of
+// the schema, treating attributes that were not written as null.
+func configValue(t *testing.T, v cty.Value, typ tftypes.Type) tftypes.Value {
+	if v == cty.NilVal || v.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		values := map[string]tftypes.Value{}
+		for name, attrType := range typ.AttributeTypes {
+			var attr cty.Value
+			if v.Type().IsObjectType() && v.Type().HasAttribute(name) {
+				attr = v.GetAttr(name)
+			}
+			values[name] = configValue(t, attr, attrType)
+		}
+		return tftypes.NewValue(typ, values)
+	case tftypes.Map:
+		values := map[string]tftypes.Value{}
+		for k, elem := range v.AsValueMap() {
+			values[k] = configValue(t, elem, typ.ElementType)
+		}
+		return tftypes.NewValue(typ, values)
+	case tftypes.List:
+		return tftypes.NewValue(typ, configElements(t, v, typ.ElementType))
+	case tftypes.Set:
+		return tftypes.NewValue(typ, configElements(t, v, typ.ElementType))
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, v.AsString())
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, v.True())
+	case typ.Is(tftypes.Number):
+		return tftypes.NewValue(typ, v.AsBigFloat())
+	}
+
+	t.Fatalf("unsupported type %s", typ)
+	return tftypes.Value{}
+}
+
+func configElements(t *testing.T, v cty.Value, elemType tftypes.Type) []tftypes.Value {
+	var values []tftypes.Value
+	for _, elem := range v.AsValueSlice() {
+		values = append(values, configValue(t, elem, elemType))
+	}
+	return values
+}
@@ -0,0 +1,265 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func (e *exporter) writeImport(typeName, label, id string) {
+	body := e.file.Body().AppendNewBlock("import", nil).Body()
+	body.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: label},
+	})
+	body.SetAttributeValue("id", cty.StringVal(id))
+	e.file.Body().AppendNewline()
+}
+
+// writeResource writes the configurable attributes of a resource. Computed-only
+// attributes and null values are left out. Unless sensitive values are
+// included, they are left out too, along with the map entries and list
+// elements that hold them, and a comment names what is missing.
+func (e *exporter) writeResource(typeName, label string, s schema.Schema, state tftypes.Value) error {
+	e.omitted = nil
+	values, err := e.objectValues(s.Attributes, state, "")
+	if err != nil {
+		return fmt.Errorf("%s.%s: %w", typeName, label, err)
+	}
+
+	body := e.file.Body().AppendNewBlock("resource", []string{typeName, label}).Body()
+	if len(e.omitted) > 0 {
+		comment := []string{
+			"# Sensitive values were left out. Set them here, or export again with",
+			"# -include-sensitive to write them:",
+		}
+		for _, p := range e.omitted {
+			comment = append(comment, "#   "+p)
+		}
+		for _, line := range comment {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte(line + "\n")}})
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		body.SetAttributeValue(name, values[name])
+	}
+	e.file.Body().AppendNewline()
+
+	return nil
+}
+
+// objectValues converts the attributes of an object. prefix is the path of the
+// object, used to name the sensitive values that are left out.
+func (e *exporter) objectValues(attrs map[string]schema.Attribute, v tftypes.Value, prefix string) (map[string]cty.Value, error) {
+	var fields map[string]tftypes.Value
+	if err := v.As(&fields); err != nil {
+		return nil, err
+	}
+
+	values := map[string]cty.Value{}
+	for _, name := range slices.Sorted(maps.Keys(attrs)) {
+		attr := attrs[name]
+		attrPath := name
+		if prefix != "" {
+			attrPath = prefix + "." + name
+		}
+		value, ok, err := e.attributeValue(attr, fields[name], attrPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if ok {
+			values[name] = value
+		}
+	}
+
+	return values, nil
+}
+
+func (e *exporter) attributeValue(attr schema.Attribute, v tftypes.Value, attrPath string) (cty.Value, bool, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return cty.NilVal, false, nil
+	}
+	if !attr.IsRequired() && !attr.IsOptional() {
+		return cty.NilVal, false, nil
+	}
+	if attr.IsSensitive() && !e.opts.IncludeSensitive {
+		e.omitted = append(e.omitted, attrPath)
+		return cty.NilVal, false, nil
+	}
+	if isDefault(attr, v) {
+		return cty.NilVal, false, nil
+	}
+
+	var nested map[string]schema.Attribute
+	switch a := attr.(type) {
+	case schema.SingleNestedAttribute:
+		values, err := e.objectValues(a.Attributes, v, attrPath)
+		if err != nil {
+			return cty.NilVal, false, err
+		}
+		return cty.ObjectVal(values), true, nil
+	case schema.ListNestedAttribute:
+		nested = a.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		nested = a.NestedObject.Attributes
+	case schema.MapNestedAttribute:
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return cty.NilVal, false, err
+		}
+
+		values := map[string]cty.Value{}
+		for _, k := range slices.Sorted(maps.Keys(elems)) {
+			value, ok, err := e.elementValue(a.NestedObject.Attributes, elems[k], fmt.Sprintf("%s[%q]", attrPath, k))
+			if err != nil {
+				return cty.NilVal, false, fmt.Errorf("%s: %w", k, err)
+			}
+			if ok {
+				values[k] = value
+			}
+		}
+		if len(values) == 0 && len(elems) > 0 {
+			return cty.NilVal, false, nil
+		}
+		return cty.ObjectVal(values), true, nil
+	default:
+		value, err := ctyValue(v)
+		return value, err == nil, err
+	}
+
+	var elems []tftypes.Value
+	if err := v.As(&elems); err != nil {
+		return cty.NilVal, false, err
+	}
+
+	values := make([]cty.Value, 0, len(elems))
+	for i, elem := range elems {
+		value, ok, err := e.elementValue(nested, elem, fmt.Sprintf("%s[%d]", attrPath, i))
+		if err != nil {
+			return cty.NilVal, false, err
+		}
+		if ok {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 && len(elems) > 0 {
+		return cty.NilVal, false, nil
+	}
+	return cty.TupleVal(values), true, nil
+}
+
+// elementValue converts an element of a nested collection. An element missing
+// a sensitive value would not be valid on its own, such as an env var without
+// a value, so it is left out as a whole.
+func (e *exporter) elementValue(attrs map[string]schema.Attribute, v tftypes.Value, elemPath string) (cty.Value, bool, error) {
+	omitted := len(e.omitted)
+	values, err := e.objectValues(attrs, v, elemPath)
+	if err != nil {
+		return cty.NilVal, false, err
+	}
+	if len(e.omitted) > omitted {
+		e.omitted = append(e.omitted[:omitted], elemPath)
+		return cty.NilVal, false, nil
+	}
+	return cty.ObjectVal(values), true, nil
+}
+
+// isDefault reports whether v is the default value of an attribute. Defaults are left
+// out, since writing some of them conflicts with other attributes, such as
+// generate_value next to the value of an env var.
+func isDefault(schemaAttr schema.Attribute, v tftypes.Value) bool {
+	ctx := context.Background()
+
+	var value attr.Value
+	switch a := schemaAttr.(type) {
+	case schema.BoolAttribute:
+		if a.Default == nil {
+			return false
+		}
+		var resp defaults.BoolResponse
+		a.Default.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+		value = resp.PlanValue
+	case schema.StringAttribute:
+		if a.Default == nil {
+			return false
+		}
+		var resp defaults.StringResponse
+		a.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+		value = resp.PlanValue
+	default:
+		return false
+	}
+
+	def, err := value.ToTerraformValue(ctx)
+	return err == nil && def.Equal(v)
+}
+
+// ctyValue converts a value without a nested schema, dropping null object
+// attributes.
+func ctyValue(v tftypes.Value) (cty.Value, error) {
+	if v.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return cty.StringVal(s), err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return cty.BoolVal(b), err
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		err := v.As(&n)
+		return cty.NumberVal(&n), err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+
+		values := make([]cty.Value, 0, len(elems))
+		for _, elem := range elems {
+			value, err := ctyValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values = append(values, value)
+		}
+		return cty.TupleVal(values), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+
+		values := map[string]cty.Value{}
+		for k, elem := range elems {
+			if elem.IsNull() {
+				continue
+			}
+			value, err := ctyValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[k] = value
+		}
+		return cty.ObjectVal(values), nil
+	}
+
+	return cty.NilVal, fmt.Errorf("unsupported type %s", typ)
+}
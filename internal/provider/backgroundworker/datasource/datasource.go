@@ -51,20 +51,33 @@ func (d *backgroundWorkerSource) Schema(ctx context.Context, _ datasource.Schema
 
 // Read refreshes the Terraform state with the latest data.
 func (d *backgroundWorkerSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan backgroundWorker.BackgroundWorkerModel
+	var plan backgroundWorker.BackgroundWorkerDataSourceModel
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, d.client, d.ownerID, client.BackgroundWorker, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find background worker", err.Error())
+		return
+	}
+	plan.Id = id
+
 	service, err := common.GetWrappedService(ctx, d.client, plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get background worker", err.Error())
 		return
 	}
 
-	backgroundWorkerModel, err := backgroundWorker.ModelForServiceResult(service, plan, resp.Diagnostics)
+	backgroundWorkerModel, err := backgroundWorker.DataSourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to apply service result to model", err.Error())
 		return
@@ -12,12 +12,12 @@ func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Background Worker.",
 		Attributes: map[string]schema.Attribute{
-			"id":                            datasource.ServiceID,
+			"id":                            datasource.LookupID("background worker"),
 			"autoscaling":                   datasource.Autoscaling,
 			"runtime_source":                datasource.RuntimeSource,
 			"disk":                          datasource.Disk,
-			"environment_id":                datasource.ResourceEnvironmentID,
-			"name":                          datasource.ServiceName,
+			"environment_id":                datasource.LookupEnvironmentID,
+			"name":                          datasource.LookupName("background worker"),
 			"slug":                          datasource.Slug,
 			"num_instances":                 datasource.NumInstances,
 			"plan":                          datasource.Plan,
@@ -1,6 +1,7 @@
 package backgroundWorker
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -26,11 +27,74 @@ type BackgroundWorkerModel struct {
 	StartCommand               types.String               `tfsdk:"start_command"`
 	MaxShutdownDelaySeconds    types.Int64                `tfsdk:"max_shutdown_delay_seconds"`
 
+	NotificationOverride types.Object `tfsdk:"notification_override"`
+	LogStreamOverride    types.Object `tfsdk:"log_stream_override"`
+}
+
+// BackgroundWorkerResourceModel adds the env vars and the settings that only
+// apply to the resource, such as deploy_wait and timeouts, to
+// BackgroundWorkerModel, which the data source also uses.
+type BackgroundWorkerResourceModel struct {
+	BackgroundWorkerModel
+
 	EnvVars     map[string]common.EnvVarModel     `tfsdk:"env_vars"`
 	SecretFiles map[string]common.SecretFileModel `tfsdk:"secret_files"`
 
-	NotificationOverride types.Object `tfsdk:"notification_override"`
-	LogStreamOverride    types.Object `tfsdk:"log_stream_override"`
+	IgnoreUnmanagedEnvVars types.Bool              `tfsdk:"ignore_unmanaged_env_vars"`
+	DeployWait             *common.DeployWaitModel `tfsdk:"deploy_wait"`
+	OnUpdate               types.String            `tfsdk:"on_update"`
+	Timeouts               timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// BackgroundWorkerDataSourceModel is the state of the
+// render_background_worker data source. Its env vars and secret files have no
+// write-only attributes.
+type BackgroundWorkerDataSourceModel struct {
+	BackgroundWorkerModel
+
+	EnvVars     map[string]common.EnvVarDataSourceModel     `tfsdk:"env_vars"`
+	SecretFiles map[string]common.SecretFileDataSourceModel `tfsdk:"secret_files"`
+}
+
+// ResourceModelForServiceResult builds the state of the
+// render_background_worker resource.
+func ResourceModelForServiceResult(service *common.WrappedService, plan BackgroundWorkerResourceModel, diags diag.Diagnostics) (*BackgroundWorkerResourceModel, error) {
+	model, err := ModelForServiceResult(service, plan.BackgroundWorkerModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	envVars := service.EnvVars
+	secretFiles := service.SecretFiles
+	if plan.IgnoreUnmanagedEnvVars.ValueBool() {
+		envVars = common.ListedEnvVars(envVars, plan.EnvVars)
+		secretFiles = common.ListedSecretFiles(secretFiles, plan.SecretFiles)
+	}
+
+	return &BackgroundWorkerResourceModel{
+		BackgroundWorkerModel:  *model,
+		EnvVars:                common.EnvVarsFromClientCursors(envVars, plan.EnvVars),
+		SecretFiles:            common.SecretFilesFromClientCursors(secretFiles, plan.SecretFiles),
+		IgnoreUnmanagedEnvVars: plan.IgnoreUnmanagedEnvVars,
+		DeployWait:             plan.DeployWait,
+		OnUpdate:               plan.OnUpdate,
+		Timeouts:               plan.Timeouts,
+	}, nil
+}
+
+// DataSourceModelForServiceResult builds the state of the
+// render_background_worker data source.
+func DataSourceModelForServiceResult(service *common.WrappedService, config BackgroundWorkerDataSourceModel, diags diag.Diagnostics) (*BackgroundWorkerDataSourceModel, error) {
+	model, err := ModelForServiceResult(service, config.BackgroundWorkerModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BackgroundWorkerDataSourceModel{
+		BackgroundWorkerModel: *model,
+		EnvVars:               common.EnvVarsForDataSource(common.EnvVarsFromClientCursors(service.EnvVars, nil)),
+		SecretFiles:           common.SecretFilesForDataSource(common.SecretFilesFromClientCursors(service.SecretFiles, nil)),
+	}, nil
 }
 
 func ModelForServiceResult(service *common.WrappedService, plan BackgroundWorkerModel, diags diag.Diagnostics) (*BackgroundWorkerModel, error) {
@@ -65,8 +129,6 @@ func ModelForServiceResult(service *common.WrappedService, plan BackgroundWorker
 
 		Autoscaling:          common.AutoscalingFromClient(details.Autoscaling, diags),
 		Disk:                 common.DiskToDiskModel(details.Disk),
-		EnvVars:              common.EnvVarsFromClientCursors(service.EnvVars, plan.EnvVars),
-		SecretFiles:          common.SecretFilesFromClientCursors(service.SecretFiles),
 		NotificationOverride: common.NotificationOverrideFromClient(service.NotificationOverride, diags),
 		LogStreamOverride:    common.LogStreamOverrideFromClient(service.LogStreamOverride, plan.LogStreamOverride, diags),
 	}
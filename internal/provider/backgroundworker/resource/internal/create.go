@@ -8,7 +8,7 @@ import (
 	"terraform-provider-render/internal/provider/common"
 )
 
-func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan backgroundWorker.BackgroundWorkerModel) (client.CreateServiceJSONRequestBody, error) {
+func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan backgroundWorker.BackgroundWorkerResourceModel) (client.CreateServiceJSONRequestBody, error) {
 	envSpecificDetails, err := common.EnvSpecificDetailsForRuntimeSource(
 		plan.RuntimeSource.Runtime(),
 		plan.RuntimeSource,
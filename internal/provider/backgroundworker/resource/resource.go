@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-render/internal/client"
@@ -12,6 +11,7 @@ import (
 	"terraform-provider-render/internal/provider/backgroundworker/resource/internal"
 	"terraform-provider-render/internal/provider/common"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 	resourcecommon "terraform-provider-render/internal/provider/types/resource"
 )
 
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                     = &backgroundWorkerResource{}
 	_ resource.ResourceWithConfigure        = &backgroundWorkerResource{}
 	_ resource.ResourceWithImportState      = &backgroundWorkerResource{}
+	_ resource.ResourceWithIdentity         = &backgroundWorkerResource{}
 	_ resource.ResourceWithConfigValidators = &backgroundWorkerResource{}
 )
 
@@ -61,16 +62,39 @@ func (r *backgroundWorkerResource) Schema(ctx context.Context, _ resource.Schema
 	resp.Schema = Schema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *backgroundWorkerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *backgroundWorkerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var shouldWaitForServiceCompletion = r.waitForDeployCompletion
-	var plan backgroundWorker.BackgroundWorkerModel
+	var plan backgroundWorker.BackgroundWorkerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+	shouldWaitForServiceCompletion := deployWait.ShouldWait()
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceDetails, err := internal.CreateServiceRequestFromModel(ctx, r.ownerID, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -79,7 +103,7 @@ func (r *backgroundWorkerResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	_, err = common.CreateService(ctx, r.client, common.CreateServiceReq{
+	service, err := common.CreateService(createCtx, r.client, common.CreateServiceReq{
 		Service:              serviceDetails,
 		EnvironmentID:        plan.EnvironmentID.ValueStringPointer(),
 		NotificationOverride: plan.NotificationOverride,
@@ -89,17 +113,25 @@ func (r *backgroundWorkerResource) Create(ctx context.Context, req resource.Crea
 		resp.Diagnostics.AddError(
 			"Error creating background worker", "Could not create background worker, unexpected error: "+err.Error(),
 		)
+		shouldWaitForServiceCompletion = false
 	}
 
-	service, err := common.GetWrappedServiceByName(ctx, r.client, r.ownerID, plan.Name.ValueString(), client.BackgroundWorker)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating background worker", "Could not find service, unexpected error: "+err.Error(),
-		)
+	if service == nil {
 		return
 	}
+	if err != nil {
+		// Re-read the partially created service so that it is tracked in
+		// state and can be repaired or destroyed by a later apply.
+		service, err = common.GetWrappedService(ctx, r.client, service.Id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating background worker", "Could not read service, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
 
-	res, err := backgroundWorker.ModelForServiceResult(service, plan, resp.Diagnostics)
+	res, err := backgroundWorker.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating background worker",
@@ -111,13 +143,14 @@ func (r *backgroundWorkerResource) Create(ctx context.Context, req resource.Crea
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *res)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 
 	if !shouldWaitForServiceCompletion {
 		return
 	}
 
 	// Wait for the service to be ready before returning
-	err = common.WaitForService(ctx, r.poller, r.client, service.Id)
+	err = common.WaitForService(createCtx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating background worker",
@@ -129,7 +162,7 @@ func (r *backgroundWorkerResource) Create(ctx context.Context, req resource.Crea
 
 // Read resource information.
 func (r *backgroundWorkerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var plan backgroundWorker.BackgroundWorkerModel
+	var plan backgroundWorker.BackgroundWorkerResourceModel
 	diags := req.State.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -150,7 +183,7 @@ func (r *backgroundWorkerResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	backgroundWorkerModel, err := backgroundWorker.ModelForServiceResult(service, plan, resp.Diagnostics)
+	backgroundWorkerModel, err := backgroundWorker.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service",
@@ -160,26 +193,52 @@ func (r *backgroundWorkerResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, backgroundWorkerModel)
+	diags = resp.State.Set(ctx, *backgroundWorkerModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *backgroundWorkerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan backgroundWorker.BackgroundWorkerModel
+	var plan backgroundWorker.BackgroundWorkerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state backgroundWorker.BackgroundWorkerModel
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+	onUpdate, err := common.UpdateStrategyForPlan(req.State, req.Plan, plan.OnUpdate, r.skipDeployAfterServiceUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service", "Could not determine how to apply the update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state backgroundWorker.BackgroundWorkerResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.UpdateServiceRequestFromModel(ctx, plan, r.ownerID)
+	serviceDetails, err := internal.UpdateServiceRequestFromModel(ctx, plan.BackgroundWorkerModel, r.ownerID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service", "Could not update service, unexpected error: "+err.Error(),
@@ -203,11 +262,12 @@ func (r *backgroundWorkerResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	service, err := common.UpdateService(ctx, r.client, r.skipDeployAfterServiceUpdate, common.UpdateServiceReq{
+	service, err := common.UpdateService(ctx, r.client, common.UpdateServiceReq{
 		ServiceID:   plan.Id.ValueString(),
 		Service:     serviceDetails,
 		EnvVars:     evs,
 		SecretFiles: common.SecretFilesToClient(plan.SecretFiles),
+		ListedEnv:   common.NewListedEnv(state.IgnoreUnmanagedEnvVars, plan.IgnoreUnmanagedEnvVars, state.EnvVars, plan.EnvVars, state.SecretFiles, plan.SecretFiles),
 		Disk: &common.DiskStateAndPlan{
 			State: state.Disk,
 			Plan:  plan.Disk,
@@ -226,6 +286,9 @@ func (r *backgroundWorkerResource) Update(ctx context.Context, req resource.Upda
 			State: state.LogStreamOverride,
 			Plan:  plan.LogStreamOverride,
 		},
+
+		OnUpdate:              onUpdate,
+		CancelPreviousDeploys: deployWait.CancelPreviousDeploys,
 	}, common.ServiceTypeBackgroundWorker)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -235,7 +298,7 @@ func (r *backgroundWorkerResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	bw, err := backgroundWorker.ModelForServiceResult(service, plan, resp.Diagnostics)
+	bw, err := backgroundWorker.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating background worker",
@@ -244,21 +307,39 @@ func (r *backgroundWorkerResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	diags = resp.State.Set(ctx, bw)
+	diags = resp.State.Set(ctx, *bw)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
+	if resp.Diagnostics.HasError() || !onUpdate.Deploys() || !deployWait.ShouldWait() {
+		return
+	}
+
+	err = common.WaitForService(ctx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service",
+			"Deploy never finished: "+err.Error(),
+		)
 		return
 	}
 }
 
 func (r *backgroundWorkerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state backgroundWorker.BackgroundWorkerModel
+	var state backgroundWorker.BackgroundWorkerResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := common.Delete(func() (*http.Response, error) {
 		return r.client.DeleteService(ctx, state.Id.ValueString())
 	})
@@ -272,8 +353,15 @@ func (r *backgroundWorkerResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *backgroundWorkerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is either the resource ID or a name reference such as
+	// name:my-api or env:<environment_id>/name:my-api.
+	common.ImportStateByName(ctx, req, resp, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, r.client, r.ownerID, client.BackgroundWorker, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
 }
 
 func (r *backgroundWorkerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
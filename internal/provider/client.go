@@ -1,69 +1,225 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand/v2"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"golang.org/x/time/rate"
 )
 
+// RetryPolicy controls how often and how long RateLimitHTTPClient retries
+// requests that failed with a transient error.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// MaxBackoff caps the wait between two attempts, including waits
+	// requested with Retry-After.
+	MaxBackoff time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 7,
+	MaxBackoff: 2 * time.Minute,
+}
+
+const initialBackoff = time.Second
+
 type RateLimitHTTPClient struct {
-	client      *http.Client
-	rateLimiter *rate.Limiter
-	sleepFunc   func(time.Duration)
+	client        *http.Client
+	defaultBucket *RateLimitBucket
+	buckets       []*RateLimitBucket
+	retryPolicy   RetryPolicy
+	sleepFunc     func(context.Context, time.Duration) error
 }
 
-func NewRateLimitHTTPClient(client *http.Client, rateLimiter *rate.Limiter, sleepFunc func(time.Duration)) *RateLimitHTTPClient {
+// NewRateLimitHTTPClient returns a client that throttles every request with
+// rateLimiter, and requests that match a bucket added with WithBucket with that
+// bucket as well.
+func NewRateLimitHTTPClient(client *http.Client, rateLimiter *rate.Limiter, retryPolicy RetryPolicy, sleepFunc func(context.Context, time.Duration) error) *RateLimitHTTPClient {
 	return &RateLimitHTTPClient{
-		client:      client,
-		rateLimiter: rateLimiter,
-		sleepFunc:   sleepFunc,
+		client:        client,
+		defaultBucket: NewRateLimitBucket("default", nil, rateLimiter),
+		retryPolicy:   retryPolicy,
+		sleepFunc:     sleepFunc,
 	}
 }
 
-var backoff = []int{1, 5, 10, 20, 40, 60, 120}
+// WithBucket also throttles the requests that match bucket with it. They
+// still count against the general rate limit.
+func (c *RateLimitHTTPClient) WithBucket(bucket *RateLimitBucket) *RateLimitHTTPClient {
+	c.buckets = append(c.buckets, bucket)
+	return c
+}
 
-func (c *RateLimitHTTPClient) Do(req *http.Request) (*http.Response, error) {
-	err := c.rateLimiter.Wait(req.Context())
-	if err != nil {
-		return nil, err
+// bucketsFor returns the buckets that req waits on. The first one is the most
+// specific and is the only one that adapts to the rate limit headers of the
+// response, since those describe the limit of the endpoint that was called.
+func (c *RateLimitHTTPClient) bucketsFor(req *http.Request) []*RateLimitBucket {
+	for _, b := range c.buckets {
+		if b.match(req) {
+			return []*RateLimitBucket{b, c.defaultBucket}
+		}
 	}
+	return []*RateLimitBucket{c.defaultBucket}
+}
 
-	res, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
+// SleepContext waits for d, or until ctx is done.
+func SleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
+}
+
+// Do sends req, retrying it when Render rate limits it and, for idempotent
+// methods, when it fails with a 502, 503, 504 or a network error.
+func (c *RateLimitHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	buckets := c.bucketsFor(req)
 
-	if res.StatusCode == http.StatusTooManyRequests {
-		if retryAt := res.Header.Get("Retry-After"); retryAt != "" {
-			seconds, err := strconv.Atoi(retryAt)
-			if err == nil {
-				c.sleepFunc(time.Duration(seconds) * time.Second)
-				res, err = c.client.Do(req)
-				if err != nil {
-					return nil, err
-				}
-				if res.StatusCode != http.StatusTooManyRequests {
-					return res, nil
-				}
+	for attempt := 0; ; attempt++ {
+		for _, bucket := range buckets {
+			if err := bucket.wait(ctx, c.sleepFunc); err != nil {
+				return nil, err
 			}
 		}
 
-		for _, seconds := range backoff {
-			c.sleepFunc(time.Duration(seconds) * time.Second)
-			res, err = c.client.Do(req)
-			if err != nil {
-				return nil, err
+		res, err := c.client.Do(req)
+		if res != nil {
+			buckets[0].adapt(ctx, res.Header, time.Now())
+		}
+		if !c.shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		if attempt >= c.retryPolicy.MaxRetries || !canRewind(req) {
+			if res != nil && res.StatusCode == http.StatusTooManyRequests {
+				discard(res)
+				return nil, fmt.Errorf("requests consistently rate limited")
+			}
+			return res, err
+		}
+
+		wait := c.backoff(attempt)
+		if res != nil {
+			if d, ok := retryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				wait = min(d, c.retryPolicy.MaxBackoff)
 			}
-			if res.StatusCode != http.StatusTooManyRequests {
-				return res, nil
+		}
+
+		tflog.Debug(ctx, "Retrying Render API request", map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"reason":  retryReason(res, err),
+		})
+
+		if res != nil {
+			discard(res)
+		}
+
+		if err := c.sleepFunc(ctx, wait); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
 			}
 		}
+	}
+}
+
+func (c *RateLimitHTTPClient) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return isIdempotent(req.Method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns an exponential backoff with equal jitter, so that half of
+// the wait is fixed and the other half random.
+func (c *RateLimitHTTPClient) backoff(attempt int) time.Duration {
+	d := c.retryPolicy.MaxBackoff
+	if attempt < 32 {
+		d = min(initialBackoff<<attempt, c.retryPolicy.MaxBackoff)
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// canRewind reports whether req can be sent again. A request body that has
+// been consumed can only be resent if it can be recreated with GetBody.
+func canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// retryAfter parses a Retry-After header, which holds either a number of
+// seconds or an HTTP date.
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
 
-		return nil, fmt.Errorf("requests consistently rate limited")
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
 	}
+	return max(at.Sub(now), 0), true
+}
+
+func retryReason(res *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return res.Status
+}
 
-	return res, nil
+// discard drains and closes the body of a response that is not returned, so
+// that the connection can be reused.
+func discard(res *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+	_ = res.Body.Close()
 }
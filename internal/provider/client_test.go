@@ -1,8 +1,12 @@
 package provider_test
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +16,16 @@ import (
 	"golang.org/x/time/rate"
 )
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func noSleep(context.Context, time.Duration) error {
+	return nil
+}
+
 func TestNewRateLimitHTTPClient(t *testing.T) {
 	t.Run("success case", func(t *testing.T) {
 		var called bool
@@ -20,7 +34,7 @@ func TestNewRateLimitHTTPClient(t *testing.T) {
 			called = true
 		}))
 
-		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(1), 1), time.Sleep)
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(1), 1), provider.DefaultRetryPolicy, provider.SleepContext)
 
 		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
 		require.NoError(t, err)
@@ -43,11 +57,12 @@ func TestNewRateLimitHTTPClient(t *testing.T) {
 			w.WriteHeader(http.StatusOK)
 		}))
 
-		fakeSleep := func(d time.Duration) {
+		fakeSleep := func(_ context.Context, d time.Duration) error {
 			require.Equal(t, 10*time.Second, d)
+			return nil
 		}
 
-		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), fakeSleep)
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), provider.DefaultRetryPolicy, fakeSleep)
 
 		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
 		require.NoError(t, err)
@@ -57,12 +72,43 @@ func TestNewRateLimitHTTPClient(t *testing.T) {
 		require.Equal(t, callCount, 2)
 	})
 
+	t.Run("waits until retry after date", func(t *testing.T) {
+		var callCount int
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			if callCount == 1 {
+				w.Header().Add("Retry-After", time.Now().Add(30*time.Second).UTC().Format(http.TimeFormat))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		fakeSleep := func(_ context.Context, d time.Duration) error {
+			require.InDelta(t, 30*time.Second, d, float64(2*time.Second))
+			return nil
+		}
+
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), provider.DefaultRetryPolicy, fakeSleep)
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, callCount, 2)
+	})
+
 	t.Run("waits until retry after, then backs off", func(t *testing.T) {
 		var callCount int
 		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			callCount++
-			if callCount <= 2 {
+			if callCount == 1 {
 				w.Header().Add("Retry-After", "10")
+			}
+			if callCount <= 2 {
 				w.WriteHeader(http.StatusTooManyRequests)
 				return
 			}
@@ -71,19 +117,21 @@ func TestNewRateLimitHTTPClient(t *testing.T) {
 		}))
 
 		var sleepCallCount int
-		fakeSleep := func(d time.Duration) {
+		fakeSleep := func(_ context.Context, d time.Duration) error {
 			sleepCallCount++
 			switch sleepCallCount {
 			case 1:
 				require.Equal(t, 10*time.Second, d)
 			case 2:
-				require.Equal(t, 1*time.Second, d)
+				require.GreaterOrEqual(t, d, 1*time.Second)
+				require.LessOrEqual(t, d, 2*time.Second)
 			default:
 				require.Fail(t, "unexpected call to sleep")
 			}
+			return nil
 		}
 
-		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), fakeSleep)
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), provider.DefaultRetryPolicy, fakeSleep)
 
 		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
 		require.NoError(t, err)
@@ -93,4 +141,164 @@ func TestNewRateLimitHTTPClient(t *testing.T) {
 		require.Equal(t, callCount, 3)
 		require.Equal(t, sleepCallCount, 2)
 	})
+
+	t.Run("caps backoff", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Retry-After", "600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+
+		var sleeps []time.Duration
+		fakeSleep := func(_ context.Context, d time.Duration) error {
+			sleeps = append(sleeps, d)
+			return nil
+		}
+
+		policy := provider.RetryPolicy{MaxRetries: 2, MaxBackoff: 5 * time.Second}
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), policy, fakeSleep)
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.EqualError(t, err, "requests consistently rate limited")
+		require.Equal(t, []time.Duration{5 * time.Second, 5 * time.Second}, sleeps)
+	})
+
+	t.Run("retries transient failures of idempotent requests", func(t *testing.T) {
+		for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+			var callCount int
+			svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				callCount++
+				if callCount == 1 {
+					w.WriteHeader(status)
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), provider.DefaultRetryPolicy, noSleep)
+
+			req, err := http.NewRequest(http.MethodDelete, svr.URL, nil)
+			require.NoError(t, err)
+
+			res, err := client.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			require.Equal(t, 2, callCount)
+		}
+	})
+
+	t.Run("does not retry transient failures of non-idempotent requests", func(t *testing.T) {
+		var callCount int
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), provider.DefaultRetryPolicy, noSleep)
+
+		req, err := http.NewRequest(http.MethodPost, svr.URL, strings.NewReader("{}"))
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadGateway, res.StatusCode)
+		require.Equal(t, 1, callCount)
+	})
+
+	t.Run("returns the last response once retries are exhausted", func(t *testing.T) {
+		var callCount int
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+
+		policy := provider.RetryPolicy{MaxRetries: 3, MaxBackoff: time.Second}
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), policy, noSleep)
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		require.Equal(t, 4, callCount)
+	})
+
+	t.Run("rewinds the body of retried requests", func(t *testing.T) {
+		var bodies []string
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+			if len(bodies) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), provider.DefaultRetryPolicy, noSleep)
+
+		req, err := http.NewRequest(http.MethodPost, svr.URL, strings.NewReader(`{"name":"svc"}`))
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, []string{`{"name":"svc"}`, `{"name":"svc"}`}, bodies)
+	})
+
+	t.Run("retries network errors of idempotent requests", func(t *testing.T) {
+		var callCount int
+		httpClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			callCount++
+			if callCount == 1 {
+				return nil, errors.New("connection reset by peer")
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+		})}
+
+		client := provider.NewRateLimitHTTPClient(httpClient, rate.NewLimiter(rate.Limit(10), 1), provider.DefaultRetryPolicy, noSleep)
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.render.com/v1/services", nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, 2, callCount)
+
+		callCount = 0
+		req, err = http.NewRequest(http.MethodPost, "https://api.render.com/v1/services", nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.Error(t, err)
+		require.Equal(t, 1, callCount)
+	})
+
+	t.Run("stops waiting when the context is canceled", func(t *testing.T) {
+		var callCount int
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		fakeSleep := func(ctx context.Context, d time.Duration) error {
+			cancel()
+			return provider.SleepContext(ctx, d)
+		}
+
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 1), provider.DefaultRetryPolicy, fakeSleep)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 1, callCount)
+	})
 }
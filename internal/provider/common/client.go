@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"net/http"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -54,25 +56,40 @@ type Poller struct {
 	cfg PollCfg
 }
 
+// Poll calls pollFunc until it is done, it fails, or timeout elapses.
 func (p *Poller) Poll(ctx context.Context, pollFunc func() (donePolling bool, err error), timeout time.Duration) error {
+	return p.PollPending(ctx, func() (string, bool, error) {
+		donePolling, err := pollFunc()
+		return "", donePolling, err
+	}, timeout)
+}
+
+// PollPending is like Poll, but pollFunc also describes what is still pending,
+// such as "waiting for the build of deploy dep-123". When timeout elapses or
+// the deadline of ctx passes, the returned TimeoutError names it.
+func (p *Poller) PollPending(ctx context.Context, pollFunc func() (pending string, donePolling bool, err error), timeout time.Duration) error {
 	pollInterval := p.cfg.StartingPollInterval
 
 	startTime := time.Now()
 
 	for {
-		if donePolling, err := pollFunc(); err != nil {
+		pending, donePolling, err := pollFunc()
+		if err != nil {
 			return err
 		} else if donePolling {
 			return nil
 		}
 
 		if time.Since(startTime) > timeout {
-			return fmt.Errorf("timed out")
+			return &TimeoutError{Timeout: timeout, Pending: pending}
 		}
 
 		select {
 		case <-time.After(pollInterval):
 		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return &TimeoutError{Timeout: timeout, Pending: pending}
+			}
 			return ctx.Err()
 		}
 
@@ -160,6 +177,9 @@ type WrappedService struct {
 	SecretFiles          *[]client.SecretFileWithCursor
 	NotificationOverride *notifications.NotificationOverride
 	LogStreamOverride    *logs.ResourceLogStreamSetting
+
+	// DeployID is the deploy started by CreateService or UpdateService, if any.
+	DeployID *string
 }
 
 func WrapService(ctx context.Context, apiClient *client.ClientWithResponses, service *client.Service) (*WrappedService, error) {
@@ -211,37 +231,32 @@ func GetWrappedService(ctx context.Context, apiClient *client.ClientWithResponse
 	return WrapService(ctx, apiClient, service)
 }
 
-func GetWrappedServiceByName(ctx context.Context, apiClient *client.ClientWithResponses, owner, name string, serviceType client.ServiceType) (*WrappedService, error) {
-	service, err := getServiceByName(ctx, apiClient, owner, name, serviceType)
-	if err != nil {
-		return nil, err
-	}
-
-	return WrapService(ctx, apiClient, service)
-}
-
-type serviceWithCursor struct {
-	Cursor  *client.Cursor  `json:"cursor,omitempty"`
-	Service *client.Service `json:"service,omitempty"`
-}
-
-func getServiceByName(ctx context.Context, apiClient *client.ClientWithResponses, owner, name string, serviceType client.ServiceType) (*client.Service, error) {
-	var res []serviceWithCursor
-	err := Get(func() (*http.Response, error) {
-		return apiClient.ListServices(ctx, &client.ListServicesParams{
-			Name:    From([]string{name}),
-			OwnerId: From([]string{owner}),
-			Type:    From([]client.ServiceType{serviceType}),
-		})
-	}, &res)
+// ListServices lists the services of a type owned by owner, optionally
+// restricted to environments.
+func ListServices(ctx context.Context, apiClient *client.ClientWithResponses, owner string, serviceType client.ServiceType, filter ListFilter, limit int64) ([]client.Service, error) {
+	res, err := ListPages(limit, func(cursor *string, pageSize int) ([]client.ServiceWithCursor, error) {
+		var page []client.ServiceWithCursor
+		err := Get(func() (*http.Response, error) {
+			return apiClient.ListServices(ctx, &client.ListServicesParams{
+				OwnerId:       From([]string{owner}),
+				Name:          filter.Names,
+				Type:          From([]client.ServiceType{serviceType}),
+				EnvironmentId: filter.EnvironmentIDs,
+				Cursor:        cursor,
+				Limit:         &pageSize,
+			})
+		}, &page)
+		return page, err
+	}, func(s client.ServiceWithCursor) string { return s.Cursor })
 	if err != nil {
-		return nil, fmt.Errorf("could not get service: %w", err)
+		return nil, fmt.Errorf("could not list services: %w", err)
 	}
 
-	if len(res) != 1 {
-		return nil, fmt.Errorf("expected one service, got %d", len(res))
+	services := make([]client.Service, 0, len(res))
+	for _, s := range res {
+		services = append(services, s.Service)
 	}
-	return res[0].Service, nil
+	return services, nil
 }
 
 func GetService(ctx context.Context, apiClient *client.ClientWithResponses, serviceID string) (*client.Service, error) {
@@ -345,24 +360,27 @@ type CreateServiceReq struct {
 	LogStreamOverride    types.Object
 }
 
-type serviceWithDeploy struct {
-	DeployId *string         `json:"deployId,omitempty"`
-	Service  *client.Service `json:"service,omitempty"`
-}
-
+// CreateService creates a service and the settings managed alongside it. If
+// the service is created but a later step fails, the service is returned
+// with the error so that it can still be recorded in state.
 func CreateService(ctx context.Context, apiClient *client.ClientWithResponses, req CreateServiceReq) (*WrappedService, error) {
-	serviceResponse := serviceWithDeploy{}
+	serviceResponse := client.ServiceAndDeploy{}
 	err := Create(func() (*http.Response, error) {
 		return apiClient.CreateService(ctx, req.Service)
 	}, &serviceResponse)
 	if err != nil {
 		return nil, fmt.Errorf("could not create service: %w", err)
 	}
+	if serviceResponse.Service == nil {
+		return nil, fmt.Errorf("could not create service: the response did not include the service")
+	}
+
+	created := &WrappedService{Service: serviceResponse.Service, DeployID: serviceResponse.DeployId}
 
 	if req.EnvironmentID != nil {
 		_, err = UpdateEnvironmentID(ctx, apiClient, serviceResponse.Service.Id, &EnvironmentIDStateAndPlan{Plan: req.EnvironmentID})
 		if err != nil {
-			return nil, fmt.Errorf("could not add service to environment: %w", err)
+			return created, fmt.Errorf("could not add service to environment: %w", err)
 		}
 		serviceResponse.Service.EnvironmentId = req.EnvironmentID
 	}
@@ -375,13 +393,13 @@ func CreateService(ctx context.Context, apiClient *client.ClientWithResponses, r
 			CustomDomainStateAndPlan{Plan: CustomDomainClientsToCustomDomainModels(&req.CustomDomains)},
 		)
 		if err != nil {
-			return nil, fmt.Errorf("could not add custom domains: %w", err)
+			return created, fmt.Errorf("could not add custom domains: %w", err)
 		}
 	}
 
 	notificationOverride, err := NotificationOverrideToClient(req.NotificationOverride)
 	if err != nil {
-		return nil, fmt.Errorf("could not process notification override: %w", err)
+		return created, fmt.Errorf("could not process notification override: %w", err)
 	}
 
 	if notificationOverride != nil {
@@ -389,13 +407,13 @@ func CreateService(ctx context.Context, apiClient *client.ClientWithResponses, r
 			return apiClient.PatchServiceNotificationOverrides(ctx, serviceResponse.Service.Id, *notificationOverride)
 		}, nil)
 		if err != nil {
-			return nil, fmt.Errorf("could not add notification overrides: %w", err)
+			return created, fmt.Errorf("could not add notification overrides: %w", err)
 		}
 	}
 
 	logStreamOverride, err := LogStreamOverrideToClient(req.LogStreamOverride)
 	if err != nil {
-		return nil, fmt.Errorf("could not process log stream override: %w", err)
+		return created, fmt.Errorf("could not process log stream override: %w", err)
 	}
 
 	if logStreamOverride != nil {
@@ -403,14 +421,15 @@ func CreateService(ctx context.Context, apiClient *client.ClientWithResponses, r
 			return apiClient.UpdateResourceLogStream(ctx, serviceResponse.Service.Id, *logStreamOverride)
 		}, nil)
 		if err != nil {
-			return nil, fmt.Errorf("could not add log stream override: %w", err)
+			return created, fmt.Errorf("could not add log stream override: %w", err)
 		}
 	}
 
 	wrappedService, err := WrapService(ctx, apiClient, serviceResponse.Service)
 	if err != nil {
-		return nil, fmt.Errorf("could not wrap service: %w", err)
+		return created, fmt.Errorf("could not wrap service: %w", err)
 	}
+	wrappedService.DeployID = serviceResponse.DeployId
 
 	return wrappedService, nil
 }
@@ -459,44 +478,190 @@ type DeployWithCursor struct {
 	Deploy *client.Deploy `json:"deploy,omitempty"`
 }
 
-func WaitForService(ctx context.Context, poller *Poller, apiClient *client.ClientWithResponses, serviceID string) error {
-	return poller.Poll(ctx, func() (bool, error) {
-		var deploys []DeployWithCursor
-		err := Get(func() (*http.Response, error) {
-			return apiClient.ListDeploys(ctx, serviceID, nil)
-		}, &deploys)
+// DeployError is returned when a deploy ends without going live.
+type DeployError struct {
+	ServiceID    string
+	DeployID     string
+	Status       client.DeployStatus
+	DashboardURL string
+
+	// LogType and Logs are the last lines of the log that explains the
+	// failure, oldest first.
+	LogType string
+	Logs    []string
+}
+
+func (e *DeployError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "deploy %s ended with status %s", e.DeployID, e.Status)
+	if e.DashboardURL != "" {
+		fmt.Fprintf(&sb, "\n\nDeploy: %s", e.DashboardURL)
+	}
+	if len(e.Logs) > 0 {
+		fmt.Fprintf(&sb, "\n\nLast %d lines of the %s log:\n%s", len(e.Logs), e.LogType, strings.Join(e.Logs, "\n"))
+	}
+	return sb.String()
+}
+
+// WaitForService waits for a deploy of a service to go live, or only for its
+// build to finish when until is DeployWaitUntilBuild. When deployID is nil, it
+// follows the most recent deploy of the service instead, which may have been
+// started by someone else. While waiting, the build and app logs of the
+// service are written to tflog.
+func WaitForService(ctx context.Context, poller *Poller, apiClient *client.ClientWithResponses, service *client.Service, deployID *string, until DeployWaitUntil, timeout time.Duration) error {
+	followCtx, stopFollowing := context.WithCancel(ctx)
+	followDone := make(chan struct{})
+	var following bool
+	defer func() {
+		stopFollowing()
+		if following {
+			<-followDone
+		}
+	}()
+
+	return poller.PollPending(ctx, func() (string, bool, error) {
+		deploy, err := getDeploy(ctx, apiClient, service.Id, deployID)
 		if err != nil {
-			return false, err
+			return "", false, err
 		}
 
-		if len(deploys) == 0 {
-			return false, nil
+		if deploy == nil || deploy.Status == nil {
+			return "waiting for a deploy to start", false, nil
 		}
 
-		latestDeploy := deploys[0]
-		for _, deploy := range deploys {
-			if latestDeploy.Deploy.CreatedAt != nil && deploy.Deploy.CreatedAt.After(*latestDeploy.Deploy.CreatedAt) {
-				latestDeploy = deploy
+		if !following {
+			following = true
+			since := time.Now()
+			if deploy.CreatedAt != nil {
+				since = *deploy.CreatedAt
 			}
+			go func() {
+				defer close(followDone)
+				followDeployLogs(followCtx, poller, apiClient, service, newDeployLogWriter(service.Id, deploy.Id, since))
+			}()
 		}
 
-		if latestDeploy.Deploy != nil && latestDeploy.Deploy.Status != nil {
-			switch *latestDeploy.Deploy.Status {
-			case client.DeployStatusLive:
-				return true, nil
-			case client.DeployStatusBuildFailed, client.DeployStatusCanceled, client.DeployStatusDeactivated, client.DeployStatusPreDeployFailed, client.DeployStatusUpdateFailed:
-				return false, fmt.Errorf("deploy failed")
-			case client.DeployStatusBuildInProgress, client.DeployStatusCreated, client.DeployStatusPreDeployInProgress, client.DeployStatusUpdateInProgress:
-				return false, nil
+		switch *deploy.Status {
+		case client.DeployStatusLive:
+			return "", true, nil
+		case client.DeployStatusDeactivated:
+			superseded, err := supersededByLiveDeploy(ctx, apiClient, service.Id, deploy)
+			if err != nil {
+				return "", false, err
+			}
+			if superseded {
+				return "", true, nil
+			}
+			return "", false, &DeployError{
+				ServiceID:    service.Id,
+				DeployID:     deploy.Id,
+				Status:       *deploy.Status,
+				DashboardURL: DeployDashboardURL(service, deploy.Id),
+			}
+		case client.DeployStatusPreDeployInProgress, client.DeployStatusUpdateInProgress:
+			if until == DeployWaitUntilBuild {
+				return "", true, nil
+			}
+		case client.DeployStatusCanceled:
+			return "", false, &DeployError{
+				ServiceID:    service.Id,
+				DeployID:     deploy.Id,
+				Status:       *deploy.Status,
+				DashboardURL: DeployDashboardURL(service, deploy.Id),
+			}
+		case client.DeployStatusBuildFailed, client.DeployStatusPreDeployFailed, client.DeployStatusUpdateFailed:
+			logType, logs := lastDeployLogs(ctx, apiClient, service, deploy)
+			return "", false, &DeployError{
+				ServiceID:    service.Id,
+				DeployID:     deploy.Id,
+				Status:       *deploy.Status,
+				DashboardURL: DeployDashboardURL(service, deploy.Id),
+				LogType:      logType,
+				Logs:         logs,
 			}
 		}
+		return deployPending(deploy.Id, *deploy.Status), false, nil
+	}, timeout)
+}
 
-		return false, nil
-	},
-		// Wait up to 3 hours for the service to be live because we must wait for the build (2 hour limit),
-		// pre deploy command (30 minute limit), and deploy (15 minute limit) to complete
-		3*60*time.Minute,
-	)
+// getDeploy returns the deploy with deployID, or the most recent deploy of the
+// service when deployID is nil. It returns nil if the service has no deploys.
+func getDeploy(ctx context.Context, apiClient *client.ClientWithResponses, serviceID string, deployID *string) (*client.Deploy, error) {
+	if deployID != nil {
+		var deploy client.Deploy
+		err := Get(func() (*http.Response, error) {
+			return apiClient.RetrieveDeploy(ctx, serviceID, *deployID)
+		}, &deploy)
+		if err != nil {
+			return nil, err
+		}
+		return &deploy, nil
+	}
+
+	var deploys []DeployWithCursor
+	err := Get(func() (*http.Response, error) {
+		return apiClient.ListDeploys(ctx, serviceID, nil)
+	}, &deploys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deploys) == 0 {
+		return nil, nil
+	}
+
+	latestDeploy := deploys[0]
+	for _, deploy := range deploys {
+		if latestDeploy.Deploy.CreatedAt != nil && deploy.Deploy.CreatedAt.After(*latestDeploy.Deploy.CreatedAt) {
+			latestDeploy = deploy
+		}
+	}
+	return latestDeploy.Deploy, nil
+}
+
+// supersededByLiveDeploy reports whether a deploy created after deploy is
+// live, in which case deploy was deactivated because it was replaced rather
+// than because the service was suspended or rolled back.
+func supersededByLiveDeploy(ctx context.Context, apiClient *client.ClientWithResponses, serviceID string, deploy *client.Deploy) (bool, error) {
+	params := &client.ListDeploysParams{Status: &[]client.DeployStatus{client.DeployStatusLive}}
+	if deploy.CreatedAt != nil {
+		params.CreatedAfter = deploy.CreatedAt
+	}
+
+	var deploys []DeployWithCursor
+	err := Get(func() (*http.Response, error) {
+		return apiClient.ListDeploys(ctx, serviceID, params)
+	}, &deploys)
+	if err != nil {
+		return false, fmt.Errorf("could not list deploys: %w", err)
+	}
+
+	for _, d := range deploys {
+		if d.Deploy == nil || d.Deploy.Id == deploy.Id || d.Deploy.Status == nil || *d.Deploy.Status != client.DeployStatusLive {
+			continue
+		}
+		if deploy.CreatedAt != nil && (d.Deploy.CreatedAt == nil || !d.Deploy.CreatedAt.After(*deploy.CreatedAt)) {
+			continue
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// deployPending describes what a deploy with the given status is waiting for.
+func deployPending(id string, status client.DeployStatus) string {
+	switch status {
+	case client.DeployStatusCreated, client.DeployStatusQueued:
+		return fmt.Sprintf("waiting for deploy %s to start", id)
+	case client.DeployStatusBuildInProgress:
+		return fmt.Sprintf("waiting for the build of deploy %s", id)
+	case client.DeployStatusPreDeployInProgress:
+		return fmt.Sprintf("waiting for the pre-deploy command of deploy %s", id)
+	case client.DeployStatusUpdateInProgress:
+		return fmt.Sprintf("waiting for deploy %s to go live", id)
+	default:
+		return fmt.Sprintf("waiting for deploy %s (%s)", id, status)
+	}
 }
 
 type UpdateServiceReq struct {
@@ -505,12 +670,20 @@ type UpdateServiceReq struct {
 	EnvironmentID        *EnvironmentIDStateAndPlan
 	EnvVars              client.EnvVarInputArray
 	SecretFiles          []client.SecretFileInput
+	ListedEnv            *ListedEnvStateAndPlan
 	CustomDomains        CustomDomainStateAndPlan
 	Disk                 *DiskStateAndPlan
 	InstanceCount        *int64
 	Autoscaling          *AutoscalingStateAndPlan
 	NotificationOverride *notifications.NotificationServiceOverridePATCH
 	LogStreamOverride    *LogStreamOverrideStateAndPlan
+
+	// OnUpdate is what happens to the running service after the update.
+	OnUpdate UpdateStrategy
+
+	// CancelPreviousDeploys cancels unfinished deploys before deploying the
+	// updated service.
+	CancelPreviousDeploys bool
 }
 
 type AutoscalingStateAndPlan struct {
@@ -554,7 +727,7 @@ var scalableServiceTypes = []ServiceType{
 	ServiceTypeBackgroundWorker,
 }
 
-func UpdateService(ctx context.Context, apiClient *client.ClientWithResponses, skipDeploy bool, req UpdateServiceReq, serviceType ServiceType) (*WrappedService, error) {
+func UpdateService(ctx context.Context, apiClient *client.ClientWithResponses, req UpdateServiceReq, serviceType ServiceType) (*WrappedService, error) {
 	// must happen before updating the service so the instance count is reflected in the service response
 	if req.InstanceCount != nil && *req.InstanceCount > 0 && slices.Contains(scalableServiceTypes, serviceType) {
 		if err := updateInstanceCount(ctx, apiClient, req.ServiceID, int(*req.InstanceCount)); err != nil {
@@ -571,14 +744,23 @@ func UpdateService(ctx context.Context, apiClient *client.ClientWithResponses, s
 		return nil, err
 	}
 
-	envVars, err := updateEnvVars(ctx, apiClient, req)
-	if err != nil {
-		return nil, err
-	}
+	var envVars *[]client.EnvVarWithCursor
+	var secretFiles *[]client.SecretFileWithCursor
+	if req.ListedEnv != nil {
+		envVars, secretFiles, err = updateListedEnv(ctx, apiClient, req.ServiceID, *req.ListedEnv)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		envVars, err = updateEnvVars(ctx, apiClient, req)
+		if err != nil {
+			return nil, err
+		}
 
-	secretFiles, err := updateSecretFiles(ctx, apiClient, req)
-	if err != nil {
-		return nil, err
+		secretFiles, err = updateSecretFiles(ctx, apiClient, req)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	disk, err := updateDisk(ctx, apiClient, req)
@@ -638,14 +820,15 @@ func UpdateService(ctx context.Context, apiClient *client.ClientWithResponses, s
 		}
 	}
 
-	if !skipDeploy {
-		err = Create(func() (*http.Response, error) {
-			return apiClient.CreateDeploy(ctx, req.ServiceID, client.CreateDeployJSONRequestBody{})
-		}, nil)
-		if err != nil {
+	if req.CancelPreviousDeploys && req.OnUpdate.Deploys() {
+		if err := CancelInProgressDeploys(ctx, apiClient, req.ServiceID); err != nil {
 			return nil, fmt.Errorf("unable to deploy service: %w", err)
 		}
 	}
+	deployID, err := applyUpdateStrategy(ctx, apiClient, req.ServiceID, req.OnUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to %s service: %w", req.OnUpdate, err)
+	}
 
 	return &WrappedService{
 		Service:              service,
@@ -654,9 +837,26 @@ func UpdateService(ctx context.Context, apiClient *client.ClientWithResponses, s
 		SecretFiles:          secretFiles,
 		NotificationOverride: notificationOverride,
 		LogStreamOverride:    logStreamOverride,
+		DeployID:             deployID,
 	}, nil
 }
 
+// CreateDeploy triggers a deploy of a service and returns its ID. The ID is
+// nil when the API queued the deploy without returning it.
+func CreateDeploy(ctx context.Context, apiClient *client.ClientWithResponses, serviceID string, body client.CreateDeployJSONRequestBody) (*string, error) {
+	var deploy client.Deploy
+	err := Create(func() (*http.Response, error) {
+		return apiClient.CreateDeploy(ctx, serviceID, body)
+	}, &deploy)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &deploy.Id, nil
+}
+
 func updateService(ctx context.Context, apiClient *client.ClientWithResponses, req UpdateServiceReq) (*client.Service, error) {
 	var res client.Service
 	err := Update(func() (*http.Response, error) {
@@ -918,3 +1118,18 @@ func namesForCustomDomainModels(cd []CustomDomainModel) []string {
 	}
 	return names
 }
+
+// FindServiceByName returns the only service of serviceType named ref.Name.
+func FindServiceByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, serviceType client.ServiceType, ref NameRef) (*client.Service, error) {
+	services, err := ListServices(ctx, apiClient, owner, serviceType, ref.Filter(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := strings.ReplaceAll(string(serviceType), "_", " ")
+	service, err := FindByName(kind, ref, services, func(s client.Service) (string, string) { return s.Id, s.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &service, nil
+}
@@ -2,8 +2,10 @@ package common_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
@@ -36,6 +38,42 @@ func TestDelete(t *testing.T) {
 	})
 }
 
+func TestPollPending(t *testing.T) {
+	t.Run("it stops once done", func(t *testing.T) {
+		var calls int
+		err := common.TestPoller.PollPending(context.Background(), func() (string, bool, error) {
+			calls++
+			return "waiting", calls == 3, nil
+		}, time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("it names the pending phase on timeout", func(t *testing.T) {
+		err := common.TestPoller.PollPending(context.Background(), func() (string, bool, error) {
+			return "waiting for the build of deploy dep-123", false, nil
+		}, 0)
+
+		var timeoutErr *common.TimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		require.Equal(t, "timed out after 0s waiting for the build of deploy dep-123", err.Error())
+	})
+
+	t.Run("it times out when the context deadline passes", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		poller := common.DefaultPoller
+		err := poller.PollPending(ctx, func() (string, bool, error) {
+			return "waiting for postgres dpg-123 to become available (status: creating)", false, nil
+		}, time.Hour)
+
+		var timeoutErr *common.TimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		require.Equal(t, "waiting for postgres dpg-123 to become available (status: creating)", timeoutErr.Pending)
+	})
+}
+
 func TestGetWrappedService(t *testing.T) {
 	t.Run("it adds env-vars", func(t *testing.T) {
 		s := &client.Service{Id: "some-service-id"}
@@ -120,6 +158,7 @@ func TestUpdateService(t *testing.T) {
 			"/services/some-service-id/deploys": func(resp http.ResponseWriter, req *http.Request) {
 				deployCalled = true
 				resp.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(resp).Encode(client.Deploy{Id: "dep-123"})
 			},
 			"/services/some-service-id/scale": func(resp http.ResponseWriter, req *http.Request) {
 				resp.WriteHeader(http.StatusAccepted)
@@ -130,8 +169,9 @@ func TestUpdateService(t *testing.T) {
 		c, err := client.NewClientWithResponses(mockAPI.URL)
 		require.NoError(t, err)
 
-		wrapped, err := common.UpdateService(context.Background(), c, false, common.UpdateServiceReq{
+		wrapped, err := common.UpdateService(context.Background(), c, common.UpdateServiceReq{
 			ServiceID: "some-service-id",
+			OnUpdate:  common.UpdateStrategyDeploy,
 			Disk: &common.DiskStateAndPlan{
 				State: &common.DiskModel{ID: types.StringValue("some-disk-id")},
 				Plan:  &common.DiskModel{ID: types.StringValue("some-disk-id")},
@@ -154,8 +194,10 @@ func TestUpdateService(t *testing.T) {
 		assert.Equal(t, "updated-disk", details.Disk.Name)
 
 		assert.True(t, deployCalled, "it should deploy the service")
+		require.NotNil(t, wrapped.DeployID)
+		assert.Equal(t, "dep-123", *wrapped.DeployID)
 	})
-	t.Run("it skips deploy when skip is set", func(t *testing.T) {
+	t.Run("it skips deploy when the strategy is none", func(t *testing.T) {
 		var deployCalled bool
 
 		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
@@ -184,8 +226,9 @@ func TestUpdateService(t *testing.T) {
 		c, err := client.NewClientWithResponses(mockAPI.URL)
 		require.NoError(t, err)
 
-		_, err = common.UpdateService(context.Background(), c, true, common.UpdateServiceReq{
+		_, err = common.UpdateService(context.Background(), c, common.UpdateServiceReq{
 			ServiceID: "some-service-id",
+			OnUpdate:  common.UpdateStrategyNone,
 			Disk: &common.DiskStateAndPlan{
 				State: &common.DiskModel{ID: types.StringValue("some-disk-id")},
 				Plan:  &common.DiskModel{ID: types.StringValue("some-disk-id")},
@@ -196,3 +239,97 @@ func TestUpdateService(t *testing.T) {
 		assert.False(t, deployCalled, "it should not deploy the service")
 	})
 }
+
+func TestWaitForService(t *testing.T) {
+	t.Run("it follows the given deploy", func(t *testing.T) {
+		var calls int
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1/deploys/dep-1": func(resp http.ResponseWriter, req *http.Request) {
+				calls++
+				status := client.DeployStatusBuildInProgress
+				if calls > 1 {
+					status = client.DeployStatusLive
+				}
+				resp.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(resp).Encode(client.Deploy{Id: "dep-1", Status: &status})
+			},
+			"/services/srv-1/deploys": func(resp http.ResponseWriter, req *http.Request) {
+				t.Error("it should not list deploys when the deploy ID is known")
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		err = common.WaitForService(context.Background(), &common.TestPoller, c, &client.Service{Id: "srv-1"}, common.From("dep-1"), common.DeployWaitUntilLive, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("it stops after the build when waiting for the build only", func(t *testing.T) {
+		status := client.DeployStatusUpdateInProgress
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1/deploys/dep-1": th.StaticResponse(client.Deploy{Id: "dep-1", Status: &status}),
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		err = common.WaitForService(context.Background(), &common.TestPoller, c, &client.Service{Id: "srv-1"}, common.From("dep-1"), common.DeployWaitUntilBuild, time.Minute)
+		require.NoError(t, err)
+	})
+
+	t.Run("it reports the final status of a failed deploy", func(t *testing.T) {
+		status := client.DeployStatusBuildFailed
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1/deploys/dep-1": th.StaticResponse(client.Deploy{Id: "dep-1", Status: &status}),
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		err = common.WaitForService(context.Background(), &common.TestPoller, c, &client.Service{Id: "srv-1"}, common.From("dep-1"), common.DeployWaitUntilLive, time.Minute)
+
+		var deployErr *common.DeployError
+		require.ErrorAs(t, err, &deployErr)
+		assert.Equal(t, "dep-1", deployErr.DeployID)
+		assert.Equal(t, client.DeployStatusBuildFailed, deployErr.Status)
+	})
+
+	t.Run("it succeeds when a newer live deploy replaced the deploy", func(t *testing.T) {
+		deactivated, live := client.DeployStatusDeactivated, client.DeployStatusLive
+		createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		newerCreatedAt := createdAt.Add(time.Minute)
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1/deploys/dep-1": th.StaticResponse(client.Deploy{Id: "dep-1", Status: &deactivated, CreatedAt: &createdAt}),
+			"/services/srv-1/deploys": th.StaticResponse([]common.DeployWithCursor{
+				{Deploy: &client.Deploy{Id: "dep-2", Status: &live, CreatedAt: &newerCreatedAt}},
+			}),
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		err = common.WaitForService(context.Background(), &common.TestPoller, c, &client.Service{Id: "srv-1"}, common.From("dep-1"), common.DeployWaitUntilLive, time.Minute)
+		require.NoError(t, err)
+	})
+
+	t.Run("it fails when the deploy was deactivated without a newer live deploy", func(t *testing.T) {
+		deactivated := client.DeployStatusDeactivated
+		createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1/deploys/dep-1": th.StaticResponse(client.Deploy{Id: "dep-1", Status: &deactivated, CreatedAt: &createdAt}),
+			"/services/srv-1/deploys":       th.StaticResponse([]common.DeployWithCursor{}),
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		err = common.WaitForService(context.Background(), &common.TestPoller, c, &client.Service{Id: "srv-1"}, common.From("dep-1"), common.DeployWaitUntilLive, time.Minute)
+
+		var deployErr *common.DeployError
+		require.ErrorAs(t, err, &deployErr)
+		assert.Equal(t, "dep-1", deployErr.DeployID)
+		assert.Equal(t, client.DeployStatusDeactivated, deployErr.Status)
+	})
+}
@@ -0,0 +1,239 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-render/internal/client"
+	clientlogs "terraform-provider-render/internal/client/logs"
+)
+
+// DeployErrorLogLines is how many lines of the failing log a DeployError
+// includes.
+const DeployErrorLogLines = 30
+
+const (
+	logTypeBuild = "build"
+	logTypeApp   = "app"
+
+	logPageSize = 100
+)
+
+// deployLogWriter writes the logs of a deploy to tflog, skipping entries it
+// has already written.
+type deployLogWriter struct {
+	fields map[string]any
+	since  time.Time
+	seen   map[string]bool
+}
+
+func newDeployLogWriter(serviceID, deployID string, since time.Time) *deployLogWriter {
+	return &deployLogWriter{
+		fields: map[string]any{"service_id": serviceID, "deploy_id": deployID},
+		since:  since,
+		seen:   map[string]bool{},
+	}
+}
+
+func (w *deployLogWriter) write(ctx context.Context, log clientlogs.Log) {
+	if w.seen[log.Id] {
+		return
+	}
+	w.seen[log.Id] = true
+	if log.Timestamp.After(w.since) {
+		w.since = log.Timestamp
+	}
+
+	fields := map[string]any{"log_type": logLabel(log, clientlogs.LogLabelNameType)}
+	for k, v := range w.fields {
+		fields[k] = v
+	}
+	tflog.Info(ctx, log.Message, fields)
+}
+
+// followDeployLogs writes the build and app logs of a service to tflog until
+// ctx is done. It subscribes to the logs when the API allows it and polls for
+// them otherwise. Following logs is best effort, so errors are only logged.
+func followDeployLogs(ctx context.Context, poller *Poller, apiClient *client.ClientWithResponses, service *client.Service, w *deployLogWriter) {
+	err := subscribeLogs(ctx, apiClient, service, w)
+	if ctx.Err() != nil {
+		return
+	}
+	tflog.Debug(ctx, "Could not subscribe to deploy logs, polling for them instead", map[string]any{"error": err.Error()})
+
+	for {
+		logs, err := listLogsForward(ctx, apiClient, service, []string{logTypeBuild, logTypeApp}, w.since, time.Now())
+		if err != nil && ctx.Err() == nil {
+			tflog.Debug(ctx, "Could not list deploy logs", map[string]any{"error": err.Error()})
+		}
+		for _, log := range logs {
+			w.write(ctx, log)
+		}
+
+		select {
+		case <-time.After(poller.cfg.StartingPollInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribeLogs streams logs over a websocket until ctx is done or the
+// subscription fails.
+func subscribeLogs(ctx context.Context, apiClient *client.ClientWithResponses, service *client.Service, w *deployLogWriter) error {
+	c, ok := apiClient.ClientInterface.(*client.Client)
+	if !ok {
+		return errors.New("log subscriptions are not supported by this client")
+	}
+
+	since := w.since
+	types := []string{logTypeBuild, logTypeApp}
+	req, err := client.NewSubscribeLogsRequest(c.Server, &client.SubscribeLogsParams{
+		OwnerId:   service.OwnerId,
+		StartTime: &since,
+		Resource:  []string{service.Id},
+		Type:      &types,
+	})
+	if err != nil {
+		return err
+	}
+	for _, edit := range c.RequestEditors {
+		if err := edit(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	conn, _, err := websocket.Dial(ctx, req.URL.String(), &websocket.DialOptions{
+		HTTPClient: &http.Client{Transport: doerTransport{doer: c.Client}},
+		HTTPHeader: req.Header,
+	})
+	if err != nil {
+		return fmt.Errorf("could not subscribe to logs: %w", err)
+	}
+	defer conn.CloseNow()
+
+	for {
+		_, data, err := conn.Read(ctx)
+		if err != nil {
+			return fmt.Errorf("log subscription ended: %w", err)
+		}
+
+		var log clientlogs.Log
+		if err := json.Unmarshal(data, &log); err != nil {
+			return fmt.Errorf("could not decode log: %w", err)
+		}
+		w.write(ctx, log)
+	}
+}
+
+// doerTransport sends requests through the API client's doer, so that the
+// websocket handshake is rate limited like every other request.
+type doerTransport struct {
+	doer client.HttpRequestDoer
+}
+
+func (t doerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.doer.Do(req)
+}
+
+// listLogsForward returns the logs of a service between start and end, oldest
+// first.
+func listLogsForward(ctx context.Context, apiClient *client.ClientWithResponses, service *client.Service, types []string, start, end time.Time) ([]clientlogs.Log, error) {
+	direction := clientlogs.Forward
+	limit := logPageSize
+	startTime, endTime := start, end
+
+	var res []clientlogs.Log
+	for {
+		var page client.Logs200Response
+		err := Get(func() (*http.Response, error) {
+			return apiClient.ListLogs(ctx, &client.ListLogsParams{
+				OwnerId:   service.OwnerId,
+				Resource:  []string{service.Id},
+				Type:      &types,
+				StartTime: &startTime,
+				EndTime:   &endTime,
+				Direction: &direction,
+				Limit:     &limit,
+			})
+		}, &page)
+		if err != nil {
+			return res, fmt.Errorf("could not list logs: %w", err)
+		}
+
+		res = append(res, page.Logs...)
+
+		if !page.HasMore || len(page.Logs) == 0 {
+			return res, nil
+		}
+		startTime, endTime = page.NextStartTime, page.NextEndTime
+	}
+}
+
+// lastDeployLogs returns the last lines of the log that explains why a deploy
+// failed: the build log for failed builds, and the app log otherwise.
+func lastDeployLogs(ctx context.Context, apiClient *client.ClientWithResponses, service *client.Service, deploy *client.Deploy) (string, []string) {
+	logType := logTypeApp
+	if deploy.Status != nil && *deploy.Status == client.DeployStatusBuildFailed {
+		logType = logTypeBuild
+	}
+
+	end := time.Now()
+	if deploy.FinishedAt != nil {
+		// Logs can trail the end of the deploy by a few seconds.
+		end = deploy.FinishedAt.Add(time.Minute)
+	}
+
+	direction := clientlogs.Backward
+	limit := DeployErrorLogLines
+	types := []string{logType}
+	var page client.Logs200Response
+	err := Get(func() (*http.Response, error) {
+		return apiClient.ListLogs(ctx, &client.ListLogsParams{
+			OwnerId:   service.OwnerId,
+			Resource:  []string{service.Id},
+			Type:      &types,
+			StartTime: deploy.CreatedAt,
+			EndTime:   &end,
+			Direction: &direction,
+			Limit:     &limit,
+		})
+	}, &page)
+	if err != nil {
+		tflog.Debug(ctx, "Could not list logs of failed deploy", map[string]any{"deploy_id": deploy.Id, "error": err.Error()})
+		return logType, nil
+	}
+
+	lines := make([]string, 0, len(page.Logs))
+	for _, log := range page.Logs {
+		lines = append(lines, log.Message)
+	}
+	slices.Reverse(lines)
+	return logType, lines
+}
+
+// DeployDashboardURL returns the dashboard page of a deploy, or an empty
+// string if the service has no dashboard URL.
+func DeployDashboardURL(service *client.Service, deployID string) string {
+	if service.DashboardUrl == "" {
+		return ""
+	}
+	return service.DashboardUrl + "/deploys/" + deployID
+}
+
+func logLabel(log clientlogs.Log, name clientlogs.LogLabelName) string {
+	for _, label := range log.Labels {
+		if label.Name == name {
+			return label.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,157 @@
+package common_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	clientlogs "terraform-provider-render/internal/client/logs"
+	"terraform-provider-render/internal/provider/common"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+var logsService = &client.Service{
+	Id:           "srv-1",
+	OwnerId:      "own-1",
+	DashboardUrl: "https://dashboard.render.com/web/srv-1",
+}
+
+func deployHandler(status func() client.DeployStatus) http.HandlerFunc {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		s := status()
+		resp.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(resp).Encode(client.Deploy{Id: "dep-1", Status: &s})
+	}
+}
+
+func logMessages(t *testing.T, output *bytes.Buffer) []string {
+	entries, err := tflogtest.MultilineJSONDecode(output)
+	require.NoError(t, err)
+
+	var messages []string
+	for _, entry := range entries {
+		if entry["deploy_id"] == "dep-1" {
+			messages = append(messages, entry["@message"].(string))
+		}
+	}
+	return messages
+}
+
+func TestWaitForServiceLogs(t *testing.T) {
+	t.Run("it polls for logs when it cannot subscribe", func(t *testing.T) {
+		var polled atomic.Bool
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1/deploys/dep-1": deployHandler(func() client.DeployStatus {
+				if polled.Load() {
+					return client.DeployStatusLive
+				}
+				return client.DeployStatusBuildInProgress
+			}),
+			"/logs": func(resp http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, "forward", req.URL.Query().Get("direction"))
+				assert.Equal(t, []string{"build", "app"}, req.URL.Query()["type"])
+				th.StaticResponse(client.Logs200Response{Logs: []clientlogs.Log{
+					{Id: "log-1", Message: "npm install"},
+				}})(resp, req)
+				polled.Store(true)
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+
+		err = common.WaitForService(ctx, &common.TestPoller, c, logsService, common.From("dep-1"), common.DeployWaitUntilLive, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"npm install"}, logMessages(t, &output))
+	})
+
+	t.Run("it subscribes to logs", func(t *testing.T) {
+		var subscribed atomic.Bool
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1/deploys/dep-1": deployHandler(func() client.DeployStatus {
+				if subscribed.Load() {
+					return client.DeployStatusLive
+				}
+				return client.DeployStatusBuildInProgress
+			}),
+			"/logs/subscribe": func(resp http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, "Bearer some-key", req.Header.Get("Authorization"))
+				conn, err := websocket.Accept(resp, req, nil)
+				require.NoError(t, err)
+				defer conn.CloseNow()
+
+				data, err := json.Marshal(clientlogs.Log{Id: "log-1", Message: "npm install"})
+				require.NoError(t, err)
+				require.NoError(t, conn.Write(req.Context(), websocket.MessageText, data))
+				subscribed.Store(true)
+
+				_, _, _ = conn.Read(req.Context())
+			},
+			"/logs": func(resp http.ResponseWriter, req *http.Request) {
+				t.Error("it should not poll for logs while subscribed")
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL, client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer some-key")
+			return nil
+		}))
+		require.NoError(t, err)
+
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+
+		err = common.WaitForService(ctx, &common.TestPoller, c, logsService, common.From("dep-1"), common.DeployWaitUntilLive, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"npm install"}, logMessages(t, &output))
+	})
+
+	t.Run("it includes the end of the failing log in the error", func(t *testing.T) {
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1/deploys/dep-1": deployHandler(func() client.DeployStatus {
+				return client.DeployStatusBuildFailed
+			}),
+			"/logs": func(resp http.ResponseWriter, req *http.Request) {
+				if req.URL.Query().Get("direction") != "backward" {
+					th.StaticResponse(client.Logs200Response{})(resp, req)
+					return
+				}
+				assert.Equal(t, []string{"build"}, req.URL.Query()["type"])
+				th.StaticResponse(client.Logs200Response{Logs: []clientlogs.Log{
+					{Id: "log-2", Message: "error: missing script: build"},
+					{Id: "log-1", Message: "npm run build"},
+				}})(resp, req)
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		err = common.WaitForService(context.Background(), &common.TestPoller, c, logsService, common.From("dep-1"), common.DeployWaitUntilLive, time.Minute)
+
+		var deployErr *common.DeployError
+		require.ErrorAs(t, err, &deployErr)
+		assert.Equal(t, []string{"npm run build", "error: missing script: build"}, deployErr.Logs)
+		assert.Equal(t, "https://dashboard.render.com/web/srv-1/deploys/dep-1", deployErr.DashboardURL)
+		assert.Equal(t, `deploy dep-1 ended with status build_failed
+
+Deploy: https://dashboard.render.com/web/srv-1/deploys/dep-1
+
+Last 2 lines of the build log:
+npm run build
+error: missing script: build`, err.Error())
+	})
+}
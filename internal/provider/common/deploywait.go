@@ -0,0 +1,95 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+)
+
+// DeployWaitUntil is how far a deploy must get before Terraform moves on.
+type DeployWaitUntil string
+
+const (
+	DeployWaitUntilLive  DeployWaitUntil = "live"
+	DeployWaitUntilBuild DeployWaitUntil = "build"
+	DeployWaitUntilNone  DeployWaitUntil = "none"
+)
+
+var DeployWaitUntilValues = []string{
+	string(DeployWaitUntilLive),
+	string(DeployWaitUntilBuild),
+	string(DeployWaitUntilNone),
+}
+
+type DeployWaitModel struct {
+	Until                 types.String `tfsdk:"until"`
+	CancelPreviousDeploys types.Bool   `tfsdk:"cancel_previous_deploys"`
+}
+
+// DeployWait is the resolved deploy_wait setting of a resource.
+type DeployWait struct {
+	Until                 DeployWaitUntil
+	CancelPreviousDeploys bool
+}
+
+// DeployWaitFromModel resolves the deploy_wait setting of a resource, falling
+// back to the provider's wait_for_deploy_completion when it is not set.
+func DeployWaitFromModel(model *DeployWaitModel, waitForDeployCompletion bool) DeployWait {
+	wait := DeployWait{Until: DeployWaitUntilNone}
+	if waitForDeployCompletion {
+		wait.Until = DeployWaitUntilLive
+	}
+	if model == nil {
+		return wait
+	}
+
+	if !model.Until.IsNull() && !model.Until.IsUnknown() {
+		wait.Until = DeployWaitUntil(model.Until.ValueString())
+	}
+	wait.CancelPreviousDeploys = model.CancelPreviousDeploys.ValueBool()
+	return wait
+}
+
+// ShouldWait reports whether Terraform should wait for deploys at all.
+func (w DeployWait) ShouldWait() bool {
+	return w.Until == DeployWaitUntilLive || w.Until == DeployWaitUntilBuild
+}
+
+var inProgressDeployStatuses = []client.DeployStatus{
+	client.DeployStatusCreated,
+	client.DeployStatusQueued,
+	client.DeployStatusBuildInProgress,
+	client.DeployStatusPreDeployInProgress,
+	client.DeployStatusUpdateInProgress,
+}
+
+// CancelInProgressDeploys cancels the deploys of a service that have not
+// finished yet, so that a new deploy does not queue up behind them.
+func CancelInProgressDeploys(ctx context.Context, apiClient *client.ClientWithResponses, serviceID string) error {
+	var deploys []DeployWithCursor
+	err := Get(func() (*http.Response, error) {
+		return apiClient.ListDeploys(ctx, serviceID, &client.ListDeploysParams{
+			Status: &inProgressDeployStatuses,
+		})
+	}, &deploys)
+	if err != nil {
+		return fmt.Errorf("could not list deploys: %w", err)
+	}
+
+	for _, deploy := range deploys {
+		if deploy.Deploy == nil {
+			continue
+		}
+		err = Create(func() (*http.Response, error) {
+			return apiClient.CancelDeploy(ctx, serviceID, deploy.Deploy.Id)
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("could not cancel deploy %s: %w", deploy.Deploy.Id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func TestDeployWaitFromModel(t *testing.T) {
+	tcs := []struct {
+		name     string
+		model    *common.DeployWaitModel
+		provider bool
+		expected common.DeployWait
+	}{
+		{
+			name:     "falls back to waiting for live",
+			provider: true,
+			expected: common.DeployWait{Until: common.DeployWaitUntilLive},
+		},
+		{
+			name:     "falls back to not waiting",
+			expected: common.DeployWait{Until: common.DeployWaitUntilNone},
+		},
+		{
+			name:     "overrides the provider",
+			model:    &common.DeployWaitModel{Until: types.StringValue("build"), CancelPreviousDeploys: types.BoolValue(true)},
+			expected: common.DeployWait{Until: common.DeployWaitUntilBuild, CancelPreviousDeploys: true},
+		},
+		{
+			name:     "keeps the provider default when until is unset",
+			model:    &common.DeployWaitModel{Until: types.StringNull(), CancelPreviousDeploys: types.BoolValue(true)},
+			provider: true,
+			expected: common.DeployWait{Until: common.DeployWaitUntilLive, CancelPreviousDeploys: true},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, common.DeployWaitFromModel(tc.model, tc.provider))
+		})
+	}
+}
+
+func TestCancelInProgressDeploys(t *testing.T) {
+	var canceled []string
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/services/srv-1/deploys": func(resp http.ResponseWriter, req *http.Request) {
+			assert.ElementsMatch(t, []string{"created", "queued", "build_in_progress", "pre_deploy_in_progress", "update_in_progress"}, req.URL.Query()["status"])
+			th.StaticResponse([]common.DeployWithCursor{
+				{Deploy: &client.Deploy{Id: "dep-1"}},
+				{Deploy: &client.Deploy{Id: "dep-2"}},
+			})(resp, req)
+		},
+		"/services/srv-1/deploys/dep-1/cancel": func(resp http.ResponseWriter, req *http.Request) {
+			canceled = append(canceled, "dep-1")
+			resp.WriteHeader(http.StatusOK)
+		},
+		"/services/srv-1/deploys/dep-2/cancel": func(resp http.ResponseWriter, req *http.Request) {
+			canceled = append(canceled, "dep-2")
+			resp.WriteHeader(http.StatusOK)
+		},
+	})
+
+	c, err := client.NewClientWithResponses(mockAPI.URL)
+	require.NoError(t, err)
+
+	require.NoError(t, common.CancelInProgressDeploys(context.Background(), c, "srv-1"))
+	assert.Equal(t, []string{"dep-1", "dep-2"}, canceled)
+}
@@ -9,10 +9,33 @@ import (
 )
 
 type EnvVarModel struct {
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	GenerateValue  types.Bool   `tfsdk:"generate_value"`
+}
+
+// EnvVarDataSourceModel is an env var as read by a data source, which has no
+// write-only value.
+type EnvVarDataSourceModel struct {
 	Value         types.String `tfsdk:"value"`
 	GenerateValue types.Bool   `tfsdk:"generate_value"`
 }
 
+// IsWriteOnly reports whether the value is supplied through value_wo and must
+// not be persisted.
+func (m EnvVarModel) IsWriteOnly() bool {
+	return !m.ValueWOVersion.IsNull() || !m.ValueWO.IsNull()
+}
+
+// value returns the value to send to Render, preferring the write-only value.
+func (m EnvVarModel) value() types.String {
+	if !m.ValueWO.IsNull() {
+		return m.ValueWO
+	}
+	return m.Value
+}
+
 func EnvVarsToClient(evs map[string]EnvVarModel) (client.EnvVarInputArray, error) {
 	if len(evs) == 0 {
 		return nil, nil
@@ -34,10 +57,10 @@ func EnvVarsToClient(evs map[string]EnvVarModel) (client.EnvVarInputArray, error
 func EnvVarToClient(k string, v EnvVarModel) (*client.EnvVarInput, error) {
 	evItem := &client.EnvVarInput{}
 
-	if !v.Value.IsNull() && !v.Value.IsUnknown() {
+	if value := v.value(); !value.IsNull() && !value.IsUnknown() {
 		err := evItem.FromEnvVarKeyValue(client.EnvVarKeyValue{
 			Key:   k,
-			Value: v.Value.ValueString(),
+			Value: value.ValueString(),
 		})
 		if err != nil {
 			return nil, err
@@ -51,7 +74,7 @@ func EnvVarToClient(k string, v EnvVarModel) (*client.EnvVarInput, error) {
 			return nil, err
 		}
 	} else {
-		return nil, fmt.Errorf("env var %s has no value, either provide a value or set generate_value to true", k)
+		return nil, fmt.Errorf("env var %s has no value, either provide a value or value_wo, or set generate_value to true", k)
 	}
 	return evItem, nil
 }
@@ -59,9 +82,9 @@ func EnvVarToClient(k string, v EnvVarModel) (*client.EnvVarInput, error) {
 func EnvVarAddUpdateToClient(k string, v EnvVarModel) (*client.AddUpdateEnvVarInput, error) {
 	evItem := &client.AddUpdateEnvVarInput{}
 
-	if !v.Value.IsNull() && !v.Value.IsUnknown() {
+	if value := v.value(); !value.IsNull() && !value.IsUnknown() {
 		err := evItem.FromEnvVarValue(client.EnvVarValue{
-			Value: v.Value.ValueString(),
+			Value: value.ValueString(),
 		})
 		if err != nil {
 			return nil, err
@@ -74,7 +97,7 @@ func EnvVarAddUpdateToClient(k string, v EnvVarModel) (*client.AddUpdateEnvVarIn
 			return nil, err
 		}
 	} else {
-		return nil, fmt.Errorf("env var %s has no value, either provide a value or set generate_value to true", k)
+		return nil, fmt.Errorf("env var %s has no value, either provide a value or value_wo, or set generate_value to true", k)
 	}
 	return evItem, nil
 }
@@ -114,13 +137,34 @@ func evFromClient(ev client.EnvVar, planEVs map[string]EnvVarModel) EnvVarModel
 	}
 
 	generateValue := types.BoolValue(false)
-	if ev, ok := planEVs[ev.Key]; ok {
-		generateValue = ev.GenerateValue
+	value := types.StringValue(ev.Value)
+	valueWOVersion := types.Int64Null()
+	if planEV, ok := planEVs[ev.Key]; ok {
+		generateValue = planEV.GenerateValue
+		if planEV.IsWriteOnly() {
+			value = types.StringNull()
+			valueWOVersion = planEV.ValueWOVersion
+		}
 	}
 
 	model := EnvVarModel{
-		Value:         types.StringValue(ev.Value),
-		GenerateValue: generateValue,
+		Value:          value,
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: valueWOVersion,
+		GenerateValue:  generateValue,
 	}
 	return model
 }
+
+// EnvVarsForDataSource drops the write-only attributes of evs.
+func EnvVarsForDataSource(evs map[string]EnvVarModel) map[string]EnvVarDataSourceModel {
+	if evs == nil {
+		return nil
+	}
+
+	res := make(map[string]EnvVarDataSourceModel, len(evs))
+	for k, v := range evs {
+		res[k] = EnvVarDataSourceModel{Value: v.Value, GenerateValue: v.GenerateValue}
+	}
+	return res
+}
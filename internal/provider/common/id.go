@@ -2,6 +2,8 @@ package common
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,3 +25,13 @@ func EnvVarsFromState(ctx context.Context, state tfsdk.State, diagnostics diag.D
 
 	return evs, !diagnostics.HasError()
 }
+
+// ParseServiceChildImportID splits an import ID of the form <service_id>/<id>,
+// used by resources that belong to a service.
+func ParseServiceChildImportID(id string) (serviceID, childID string, err error) {
+	serviceID, childID, ok := strings.Cut(id, "/")
+	if !ok || serviceID == "" || childID == "" {
+		return "", "", fmt.Errorf("expected an import ID of the form <service_id>/<id>, got %q", id)
+	}
+	return serviceID, childID, nil
+}
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdentityModel is the resource identity shared by Render resources.
+type IdentityModel struct {
+	OwnerID types.String `tfsdk:"owner_id"`
+	ID      types.String `tfsdk:"id"`
+}
+
+// SetIdentity records the owner and ID of the resource that was just written to
+// state. It is a no-op when Terraform doesn't support resource identity.
+func SetIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, ownerID string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	var id types.String
+	diags := state.GetAttribute(ctx, path.Root("id"), &id)
+	if diags.HasError() || id.IsNull() || id.IsUnknown() {
+		return diags
+	}
+
+	diags.Append(identity.Set(ctx, IdentityModel{
+		OwnerID: types.StringValue(ownerID),
+		ID:      id,
+	})...)
+	return diags
+}
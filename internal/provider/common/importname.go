@@ -0,0 +1,113 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NameRef refers to a resource by name, optionally within an environment,
+// instead of by ID.
+type NameRef struct {
+	Name          string
+	EnvironmentID *string
+}
+
+// Filter returns the List* filter that matches the reference.
+func (n NameRef) Filter() ListFilter {
+	filter := ListFilter{Names: From([]string{n.Name})}
+	if n.EnvironmentID != nil {
+		filter.EnvironmentIDs = From([]string{*n.EnvironmentID})
+	}
+	return filter
+}
+
+func (n NameRef) String() string {
+	if n.EnvironmentID != nil {
+		return fmt.Sprintf("%q in environment %s", n.Name, *n.EnvironmentID)
+	}
+	return fmt.Sprintf("%q", n.Name)
+}
+
+// ParseNameImportID parses import IDs of the form name:<name> or
+// env:<environment_id>/name:<name>. Any other ID is an opaque resource ID.
+func ParseNameImportID(id string) (NameRef, bool) {
+	var ref NameRef
+
+	if rest, ok := strings.CutPrefix(id, "env:"); ok {
+		envID, name, ok := strings.Cut(rest, "/")
+		if !ok || envID == "" {
+			return NameRef{}, false
+		}
+		ref.EnvironmentID = &envID
+		id = name
+	}
+
+	name, ok := strings.CutPrefix(id, "name:")
+	if !ok || name == "" {
+		return NameRef{}, false
+	}
+	ref.Name = name
+
+	return ref, true
+}
+
+// FindByName returns the only item that is named exactly ref.Name. Server-side
+// name filters are not relied on to be exact.
+func FindByName[T any](kind string, ref NameRef, items []T, describe func(T) (id, name string)) (T, error) {
+	var matches []T
+	var ids []string
+	for _, item := range items {
+		id, name := describe(item)
+		if name == ref.Name {
+			matches = append(matches, item)
+			ids = append(ids, id)
+		}
+	}
+
+	var zero T
+	switch len(matches) {
+	case 0:
+		return zero, fmt.Errorf("no %s named %s was found", kind, ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return zero, fmt.Errorf("%d %ss are named %s (%s); use an ID or narrow the match with an environment", len(matches), kind, ref, strings.Join(ids, ", "))
+	}
+}
+
+// ImportStateByName imports a resource by ID, or by name when the import ID
+// has the form name:<name> or env:<environment_id>/name:<name>. lookup
+// resolves a name to the resource's ID.
+func ImportStateByName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, lookup func(NameRef) (string, error)) {
+	if ref, ok := ParseNameImportID(req.ID); ok {
+		id, err := lookup(ref)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to import by name", err.Error())
+			return
+		}
+		req.ID = id
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// ResolveID returns id when it is set, and otherwise looks up the object named
+// name, optionally within environmentID. It backs data sources that accept
+// either an id or a name.
+func ResolveID(id, name, environmentID types.String, lookup func(NameRef) (string, error)) (types.String, error) {
+	if !id.IsNull() && !id.IsUnknown() {
+		return id, nil
+	}
+
+	ref := NameRef{Name: name.ValueString(), EnvironmentID: environmentID.ValueStringPointer()}
+	resolved, err := lookup(ref)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(resolved), nil
+}
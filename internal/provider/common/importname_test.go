@@ -0,0 +1,97 @@
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func TestParseNameImportID(t *testing.T) {
+	tcs := []struct {
+		id          string
+		ok          bool
+		name        string
+		environment string
+	}{
+		{id: "srv-123"},
+		{id: "name:my-api", ok: true, name: "my-api"},
+		{id: "env:evm-123/name:my-api", ok: true, name: "my-api", environment: "evm-123"},
+		{id: "name:"},
+		{id: "env:evm-123"},
+		{id: "env:/name:my-api"},
+		{id: "env:evm-123/my-api"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.id, func(t *testing.T) {
+			ref, ok := common.ParseNameImportID(tc.id)
+			require.Equal(t, tc.ok, ok)
+			if !ok {
+				return
+			}
+
+			assert.Equal(t, tc.name, ref.Name)
+			if tc.environment == "" {
+				assert.Nil(t, ref.EnvironmentID)
+			} else {
+				require.NotNil(t, ref.EnvironmentID)
+				assert.Equal(t, tc.environment, *ref.EnvironmentID)
+			}
+		})
+	}
+}
+
+func TestFindServiceByName(t *testing.T) {
+	var query string
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/services": func(resp http.ResponseWriter, req *http.Request) {
+			query = req.URL.RawQuery
+			switch req.URL.Query().Get("name") {
+			case "my-api":
+				th.StaticResponse(`[
+					{"cursor": "c1", "service": {"id": "srv-1", "name": "my-api"}},
+					{"cursor": "c2", "service": {"id": "srv-2", "name": "my-api-staging"}}
+				]`)(resp, req)
+			case "shared":
+				th.StaticResponse(`[
+					{"cursor": "c1", "service": {"id": "srv-3", "name": "shared"}},
+					{"cursor": "c2", "service": {"id": "srv-4", "name": "shared"}}
+				]`)(resp, req)
+			default:
+				th.StaticResponse(`[]`)(resp, req)
+			}
+		},
+	})
+	defer mockAPI.Close()
+
+	c, err := client.NewClientWithResponses(mockAPI.URL)
+	require.NoError(t, err)
+
+	t.Run("it finds the exact match", func(t *testing.T) {
+		env := "evm-123"
+		service, err := common.FindServiceByName(context.Background(), c, "own-123", client.WebService, common.NameRef{Name: "my-api", EnvironmentID: &env})
+		require.NoError(t, err)
+
+		assert.Equal(t, "srv-1", service.Id)
+		assert.Contains(t, query, "environmentId=evm-123")
+		assert.Contains(t, query, "name=my-api")
+	})
+
+	t.Run("it fails when nothing matches", func(t *testing.T) {
+		_, err := common.FindServiceByName(context.Background(), c, "own-123", client.WebService, common.NameRef{Name: "missing"})
+		assert.EqualError(t, err, `no web service named "missing" was found`)
+	})
+
+	t.Run("it fails when the name is ambiguous", func(t *testing.T) {
+		_, err := common.FindServiceByName(context.Background(), c, "own-123", client.WebService, common.NameRef{Name: "shared"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2 web services are named \"shared\" (srv-3, srv-4)")
+	})
+}
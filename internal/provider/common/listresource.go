@@ -0,0 +1,156 @@
+package common
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const listPageSize = 100
+
+// ListFilter narrows the results of the List* endpoints. Nil fields are not
+// sent.
+type ListFilter struct {
+	Names          *[]string
+	EnvironmentIDs *[]string
+}
+
+// ListPages pages through a cursor-paginated List endpoint until it is
+// exhausted or limit items have been collected. A limit of zero means no limit.
+func ListPages[T any](limit int64, fetch func(cursor *string, pageSize int) ([]T, error), cursorOf func(T) string) ([]T, error) {
+	var res []T
+	var cursor *string
+
+	for limit <= 0 || int64(len(res)) < limit {
+		page, err := fetch(cursor, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		res = append(res, page...)
+		cursor = From(cursorOf(page[len(page)-1]))
+
+		if len(page) < listPageSize {
+			break
+		}
+	}
+
+	if limit > 0 && int64(len(res)) > limit {
+		res = res[:limit]
+	}
+
+	return res, nil
+}
+
+// ListResults streams a list result for each item.
+func ListResults[T any](ctx context.Context, req list.ListRequest, r resource.Resource, ownerID string, items []T, describe func(T) (id, displayName string)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for _, item := range items {
+			id, displayName := describe(item)
+			if !push(ListResult(ctx, req, r, ownerID, id, displayName)) {
+				return
+			}
+		}
+	}
+}
+
+// ListResult builds the result for one listed resource. When Terraform asks for
+// the resource itself, it is read through the managed resource's Read so that
+// the result matches what an import would produce.
+func ListResult(ctx context.Context, req list.ListRequest, r resource.Resource, ownerID, id, displayName string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	result.Diagnostics.Append(result.Identity.Set(ctx, IdentityModel{
+		OwnerID: types.StringValue(ownerID),
+		ID:      types.StringValue(id),
+	})...)
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	// The resource is imported first, so that Read sees the same state and
+	// private state as it does right after an import.
+	state := tfsdk.State{Schema: req.ResourceSchema, Raw: result.Resource.Raw}
+	private := newPrivateState(resource.ReadRequest{}.Private)
+	if ri, ok := r.(resource.ResourceWithImportState); ok {
+		importResp := resource.ImportStateResponse{State: state, Private: private}
+		ri.ImportState(ctx, resource.ImportStateRequest{ID: id}, &importResp)
+		result.Diagnostics.Append(importResp.Diagnostics...)
+		state = importResp.State
+	} else {
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), id)...)
+	}
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	// Read records the provider's owner as the identity, so it gets its own copy
+	// to keep the owner that was listed.
+	identity := &tfsdk.ResourceIdentity{Schema: result.Identity.Schema, Raw: result.Identity.Raw.Copy()}
+	readResp := resource.ReadResponse{State: state, Identity: identity, Private: private}
+	r.Read(ctx, resource.ReadRequest{State: state, Identity: identity, Private: private}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	result.Resource.Raw = readResp.State.Raw
+
+	return result
+}
+
+// newPrivateState returns empty private state for a resource. The framework
+// does not export its private state type, so it is inferred from a nil value.
+func newPrivateState[T any](_ *T) *T {
+	return new(T)
+}
+
+// ListOwnerID returns the owner_id filter of a list resource, defaulting to the
+// provider's owner.
+func ListOwnerID(ctx context.Context, config tfsdk.Config, defaultOwnerID string) (string, diag.Diagnostics) {
+	var ownerID types.String
+	diags := config.GetAttribute(ctx, path.Root("owner_id"), &ownerID)
+	if ownerID.IsNull() || ownerID.ValueString() == "" {
+		return defaultOwnerID, diags
+	}
+	return ownerID.ValueString(), diags
+}
+
+// ListEnvironmentIDs returns the environment_ids filter of a list resource, or
+// nil when it is unset.
+func ListEnvironmentIDs(ctx context.Context, config tfsdk.Config) (*[]string, diag.Diagnostics) {
+	var environmentIDs []string
+	diags := config.GetAttribute(ctx, path.Root("environment_ids"), &environmentIDs)
+	if len(environmentIDs) == 0 {
+		return nil, diags
+	}
+	return &environmentIDs, diags
+}
+
+// ListFilterFromSets builds a filter from the names and environment_ids
+// attributes of a plural data source. Unset or empty sets do not filter.
+func ListFilterFromSets(ctx context.Context, names, environmentIDs types.Set) (ListFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filter ListFilter
+
+	var values []string
+	diags.Append(names.ElementsAs(ctx, &values, false)...)
+	if len(values) > 0 {
+		filter.Names = &values
+	}
+
+	var ids []string
+	diags.Append(environmentIDs.ElementsAs(ctx, &ids, false)...)
+	if len(ids) > 0 {
+		filter.EnvironmentIDs = &ids
+	}
+
+	return filter, diags
+}
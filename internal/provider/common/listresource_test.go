@@ -0,0 +1,67 @@
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func servicePage(from, to int) []map[string]any {
+	var page []map[string]any
+	for i := from; i < to; i++ {
+		page = append(page, map[string]any{
+			"cursor":  fmt.Sprintf("cursor-%d", i),
+			"service": map[string]any{"id": fmt.Sprintf("srv-%d", i), "name": fmt.Sprintf("service-%d", i)},
+		})
+	}
+	return page
+}
+
+func TestListServices(t *testing.T) {
+	var queries []string
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/services": func(resp http.ResponseWriter, req *http.Request) {
+			queries = append(queries, req.URL.RawQuery)
+			if req.URL.Query().Get("cursor") == "" {
+				th.StaticResponse(servicePage(0, 100))(resp, req)
+				return
+			}
+			th.StaticResponse(servicePage(100, 120))(resp, req)
+		},
+	})
+	defer mockAPI.Close()
+
+	c, err := client.NewClientWithResponses(mockAPI.URL)
+	require.NoError(t, err)
+
+	t.Run("it pages until the results are exhausted", func(t *testing.T) {
+		queries = nil
+		services, err := common.ListServices(context.Background(), c, "own-123", client.WebService, common.ListFilter{EnvironmentIDs: &[]string{"evm-123"}}, 0)
+		require.NoError(t, err)
+
+		require.Len(t, services, 120)
+		assert.Equal(t, "srv-119", services[119].Id)
+		require.Len(t, queries, 2)
+		assert.Contains(t, queries[0], "ownerId=own-123")
+		assert.Contains(t, queries[0], "type=web_service")
+		assert.Contains(t, queries[0], "environmentId=evm-123")
+		assert.Contains(t, queries[1], "cursor=cursor-99")
+	})
+
+	t.Run("it stops at the limit", func(t *testing.T) {
+		queries = nil
+		services, err := common.ListServices(context.Background(), c, "own-123", client.WebService, common.ListFilter{}, 10)
+		require.NoError(t, err)
+
+		assert.Len(t, services, 10)
+		assert.Len(t, queries, 1)
+	})
+}
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-render/internal/client"
+)
+
+// UpdateStrategy is what happens to a running service after it is updated.
+type UpdateStrategy string
+
+const (
+	UpdateStrategyDeploy           UpdateStrategy = "deploy"
+	UpdateStrategyDeployClearCache UpdateStrategy = "deploy_clear_cache"
+	UpdateStrategyRestart          UpdateStrategy = "restart"
+	UpdateStrategyNone             UpdateStrategy = "none"
+)
+
+var UpdateStrategyValues = []string{
+	string(UpdateStrategyDeploy),
+	string(UpdateStrategyDeployClearCache),
+	string(UpdateStrategyRestart),
+	string(UpdateStrategyNone),
+}
+
+// Deploys reports whether the strategy starts a deploy.
+func (s UpdateStrategy) Deploys() bool {
+	return s == UpdateStrategyDeploy || s == UpdateStrategyDeployClearCache
+}
+
+// nonRuntimeAttributes are the service attributes that Render applies without
+// a deploy or restart, along with computed attributes that only report on the
+// service.
+var nonRuntimeAttributes = []string{
+	"id",
+	"slug",
+	"url",
+	"active_custom_domains",
+	"custom_domains",
+	"environment_id",
+	"notification_override",
+	"log_stream_override",
+	"autoscaling",
+	"num_instances",
+	"previews",
+	"pull_request_previews_enabled",
+	"maintenance_mode",
+	"ip_allow_list",
+	"headers",
+	"routes",
+	"ignore_unmanaged_env_vars",
+	"deploy_wait",
+	"on_update",
+	"timeouts",
+}
+
+// UpdateStrategyForPlan resolves the on_update setting of a service for an
+// update. When on_update is not set, services are deployed unless the
+// provider's skip_deploy_after_service_update is set. Whatever the setting,
+// the service is left alone when the plan only changes attributes that don't
+// affect the running service.
+func UpdateStrategyForPlan(state tfsdk.State, plan tfsdk.Plan, onUpdate types.String, skipDeployAfterServiceUpdate bool) (UpdateStrategy, error) {
+	strategy := UpdateStrategyDeploy
+	if skipDeployAfterServiceUpdate {
+		strategy = UpdateStrategyNone
+	}
+	if !onUpdate.IsNull() && !onUpdate.IsUnknown() {
+		strategy = UpdateStrategy(onUpdate.ValueString())
+	}
+	if strategy == UpdateStrategyNone {
+		return strategy, nil
+	}
+
+	diffs, err := state.Raw.Diff(plan.Raw)
+	if err != nil {
+		return "", fmt.Errorf("could not compare the plan to the state: %w", err)
+	}
+	for _, diff := range diffs {
+		steps := diff.Path.Steps()
+		if len(steps) == 0 {
+			return strategy, nil
+		}
+		name, ok := steps[0].(tftypes.AttributeName)
+		if !ok || !slices.Contains(nonRuntimeAttributes, string(name)) {
+			return strategy, nil
+		}
+		// Turning ignore_unmanaged_env_vars off removes the keys that were set
+		// elsewhere, which the running service only picks up with a deploy.
+		if name == "ignore_unmanaged_env_vars" && !isTrue(diff.Value2) {
+			return strategy, nil
+		}
+	}
+	return UpdateStrategyNone, nil
+}
+
+func isTrue(v *tftypes.Value) bool {
+	var b bool
+	return v != nil && v.IsKnown() && !v.IsNull() && v.As(&b) == nil && b
+}
+
+// applyUpdateStrategy deploys or restarts an updated service. It returns the
+// ID of the deploy it started, if any.
+func applyUpdateStrategy(ctx context.Context, apiClient *client.ClientWithResponses, serviceID string, strategy UpdateStrategy) (*string, error) {
+	switch strategy {
+	case UpdateStrategyDeploy:
+		return CreateDeploy(ctx, apiClient, serviceID, client.CreateDeployJSONRequestBody{})
+	case UpdateStrategyDeployClearCache:
+		return CreateDeploy(ctx, apiClient, serviceID, client.CreateDeployJSONRequestBody{
+			ClearCache: From(client.Clear),
+		})
+	case UpdateStrategyRestart:
+		err := Create(func() (*http.Response, error) {
+			return apiClient.RestartService(ctx, serviceID)
+		}, nil)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,173 @@
+package common_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+var strategyTestType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"start_command":             tftypes.String,
+	"num_instances":             tftypes.Number,
+	"notification_override":     tftypes.String,
+	"url":                       tftypes.String,
+	"ignore_unmanaged_env_vars": tftypes.Bool,
+}}
+
+func strategyTestValue(startCommand string, numInstances int64, url any) tftypes.Value {
+	return strategyTestValueIgnoringUnmanaged(startCommand, numInstances, url, false)
+}
+
+func strategyTestValueIgnoringUnmanaged(startCommand string, numInstances int64, url any, ignoreUnmanaged bool) tftypes.Value {
+	return tftypes.NewValue(strategyTestType, map[string]tftypes.Value{
+		"start_command":             tftypes.NewValue(tftypes.String, startCommand),
+		"num_instances":             tftypes.NewValue(tftypes.Number, numInstances),
+		"notification_override":     tftypes.NewValue(tftypes.String, nil),
+		"url":                       tftypes.NewValue(tftypes.String, url),
+		"ignore_unmanaged_env_vars": tftypes.NewValue(tftypes.Bool, ignoreUnmanaged),
+	})
+}
+
+func TestUpdateStrategyForPlan(t *testing.T) {
+	defaultState := strategyTestValue("npm start", 1, "https://example.onrender.com")
+
+	tcs := []struct {
+		name     string
+		state    tftypes.Value
+		plan     tftypes.Value
+		onUpdate types.String
+		skip     bool
+		expected common.UpdateStrategy
+	}{
+		{
+			name:     "deploys runtime changes by default",
+			plan:     strategyTestValue("npm run start", 1, tftypes.UnknownValue),
+			onUpdate: types.StringNull(),
+			expected: common.UpdateStrategyDeploy,
+		},
+		{
+			name:     "uses on_update for runtime changes",
+			plan:     strategyTestValue("npm run start", 1, tftypes.UnknownValue),
+			onUpdate: types.StringValue("restart"),
+			expected: common.UpdateStrategyRestart,
+		},
+		{
+			name:     "skips the deploy when only other settings change",
+			plan:     strategyTestValue("npm start", 3, tftypes.UnknownValue),
+			onUpdate: types.StringValue("deploy_clear_cache"),
+			expected: common.UpdateStrategyNone,
+		},
+		{
+			name:     "falls back to skip_deploy_after_service_update",
+			plan:     strategyTestValue("npm run start", 1, "https://example.onrender.com"),
+			onUpdate: types.StringNull(),
+			skip:     true,
+			expected: common.UpdateStrategyNone,
+		},
+		{
+			name:     "prefers on_update over skip_deploy_after_service_update",
+			plan:     strategyTestValue("npm run start", 1, "https://example.onrender.com"),
+			onUpdate: types.StringValue("deploy"),
+			skip:     true,
+			expected: common.UpdateStrategyDeploy,
+		},
+		{
+			name:     "skips the deploy when ignore_unmanaged_env_vars is turned on",
+			plan:     strategyTestValueIgnoringUnmanaged("npm start", 1, "https://example.onrender.com", true),
+			onUpdate: types.StringNull(),
+			expected: common.UpdateStrategyNone,
+		},
+		{
+			name:     "deploys when ignore_unmanaged_env_vars is turned off",
+			state:    strategyTestValueIgnoringUnmanaged("npm start", 1, "https://example.onrender.com", true),
+			plan:     strategyTestValue("npm start", 1, "https://example.onrender.com"),
+			onUpdate: types.StringNull(),
+			expected: common.UpdateStrategyDeploy,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			state := tc.state
+			if state.IsNull() {
+				state = defaultState
+			}
+			strategy, err := common.UpdateStrategyForPlan(tfsdk.State{Raw: state}, tfsdk.Plan{Raw: tc.plan}, tc.onUpdate, tc.skip)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, strategy)
+		})
+	}
+}
+
+func TestUpdateServiceStrategies(t *testing.T) {
+	newMockAPI := func(deploys, restarts *[]string) *client.ClientWithResponses {
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-1":              th.StaticResponse(&client.Service{Id: "srv-1"}),
+			"/services/srv-1/env-vars":     th.StaticResponse([]client.EnvVarWithCursor{}),
+			"/services/srv-1/secret-files": th.StaticResponse([]client.SecretFileWithCursor{}),
+			"/services/srv-1/deploys": func(resp http.ResponseWriter, req *http.Request) {
+				var body client.CreateDeployJSONRequestBody
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				clearCache := ""
+				if body.ClearCache != nil {
+					clearCache = string(*body.ClearCache)
+				}
+				*deploys = append(*deploys, clearCache)
+
+				resp.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(resp).Encode(client.Deploy{Id: "dep-1"})
+			},
+			"/services/srv-1/restart": func(resp http.ResponseWriter, req *http.Request) {
+				*restarts = append(*restarts, req.Method)
+				resp.WriteHeader(http.StatusOK)
+			},
+			"/notification-settings/overrides/services/srv-1": th.StaticResponse(struct{}{}),
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("it clears the build cache", func(t *testing.T) {
+		var deploys, restarts []string
+		c := newMockAPI(&deploys, &restarts)
+
+		wrapped, err := common.UpdateService(context.Background(), c, common.UpdateServiceReq{
+			ServiceID: "srv-1",
+			OnUpdate:  common.UpdateStrategyDeployClearCache,
+		}, common.ServiceTypeWebService)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"clear"}, deploys)
+		assert.Empty(t, restarts)
+		require.NotNil(t, wrapped.DeployID)
+		assert.Equal(t, "dep-1", *wrapped.DeployID)
+	})
+
+	t.Run("it restarts instead of deploying", func(t *testing.T) {
+		var deploys, restarts []string
+		c := newMockAPI(&deploys, &restarts)
+
+		wrapped, err := common.UpdateService(context.Background(), c, common.UpdateServiceReq{
+			ServiceID: "srv-1",
+			OnUpdate:  common.UpdateStrategyRestart,
+		}, common.ServiceTypeWebService)
+		require.NoError(t, err)
+
+		assert.Empty(t, deploys)
+		assert.Equal(t, []string{http.MethodPost}, restarts)
+		assert.Nil(t, wrapped.DeployID)
+	})
+}
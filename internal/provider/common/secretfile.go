@@ -1,11 +1,36 @@
 package common
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
 	"terraform-provider-render/internal/client"
 )
 
 type SecretFileModel struct {
-	Content string `tfsdk:"content"`
+	Content          types.String `tfsdk:"content"`
+	ContentWO        types.String `tfsdk:"content_wo"`
+	ContentWOVersion types.Int64  `tfsdk:"content_wo_version"`
+}
+
+// SecretFileDataSourceModel is a secret file as read by a data source, which
+// has no write-only content.
+type SecretFileDataSourceModel struct {
+	Content types.String `tfsdk:"content"`
+}
+
+// IsWriteOnly reports whether the content is supplied through content_wo and
+// must not be persisted.
+func (m SecretFileModel) IsWriteOnly() bool {
+	return !m.ContentWOVersion.IsNull() || !m.ContentWO.IsNull()
+}
+
+// ContentValue returns the content to send to Render, preferring the
+// write-only content.
+func (m SecretFileModel) ContentValue() string {
+	if !m.ContentWO.IsNull() {
+		return m.ContentWO.ValueString()
+	}
+	return m.Content.ValueString()
 }
 
 func SecretFilesToClient(sfs map[string]SecretFileModel) []client.SecretFileInput {
@@ -17,14 +42,14 @@ func SecretFilesToClient(sfs map[string]SecretFileModel) []client.SecretFileInpu
 	for k, v := range sfs {
 		res = append(res, client.SecretFileInput{
 			Name:    k,
-			Content: v.Content,
+			Content: v.ContentValue(),
 		})
 	}
 
 	return res
 }
 
-func SecretFilesFromClientCursors(sfs *[]client.SecretFileWithCursor) map[string]SecretFileModel {
+func SecretFilesFromClientCursors(sfs *[]client.SecretFileWithCursor, planSFs map[string]SecretFileModel) map[string]SecretFileModel {
 	res := map[string]SecretFileModel{}
 
 	if sfs == nil || len(*sfs) == 0 {
@@ -32,13 +57,13 @@ func SecretFilesFromClientCursors(sfs *[]client.SecretFileWithCursor) map[string
 	}
 
 	for _, sf := range *sfs {
-		res[sf.SecretFile.Name] = SecretFileModel{Content: sf.SecretFile.Content}
+		res[sf.SecretFile.Name] = sfFromClient(sf.SecretFile, planSFs)
 	}
 
 	return res
 }
 
-func SecretFilesFromClient(sfs *[]client.SecretFile) map[string]SecretFileModel {
+func SecretFilesFromClient(sfs *[]client.SecretFile, planSFs map[string]SecretFileModel) map[string]SecretFileModel {
 	res := map[string]SecretFileModel{}
 
 	if sfs == nil || len(*sfs) == 0 {
@@ -46,8 +71,36 @@ func SecretFilesFromClient(sfs *[]client.SecretFile) map[string]SecretFileModel
 	}
 
 	for _, sf := range *sfs {
-		res[sf.Name] = SecretFileModel{Content: sf.Content}
+		res[sf.Name] = sfFromClient(sf, planSFs)
+	}
+
+	return res
+}
+
+func sfFromClient(sf client.SecretFile, planSFs map[string]SecretFileModel) SecretFileModel {
+	model := SecretFileModel{
+		Content:          types.StringValue(sf.Content),
+		ContentWO:        types.StringNull(),
+		ContentWOVersion: types.Int64Null(),
+	}
+
+	if planSF, ok := planSFs[sf.Name]; ok && planSF.IsWriteOnly() {
+		model.Content = types.StringNull()
+		model.ContentWOVersion = planSF.ContentWOVersion
 	}
 
+	return model
+}
+
+// SecretFilesForDataSource drops the write-only attributes of sfs.
+func SecretFilesForDataSource(sfs map[string]SecretFileModel) map[string]SecretFileDataSourceModel {
+	if sfs == nil {
+		return nil
+	}
+
+	res := make(map[string]SecretFileDataSourceModel, len(sfs))
+	for k, v := range sfs {
+		res[k] = SecretFileDataSourceModel{Content: v.Content}
+	}
 	return res
 }
@@ -0,0 +1,211 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+)
+
+// ListedEnvStateAndPlan is set on an update when a service only manages the
+// env vars and secret files it lists. Listed keys are written one at a time
+// and keys that are no longer listed are deleted, so keys set elsewhere, for
+// example by render_env_var, are left alone.
+type ListedEnvStateAndPlan struct {
+	StateEnvVars     map[string]EnvVarModel
+	PlanEnvVars      map[string]EnvVarModel
+	StateSecretFiles map[string]SecretFileModel
+	PlanSecretFiles  map[string]SecretFileModel
+}
+
+// NewListedEnv returns the listed env of an update, or nil when the service
+// manages every key. Keys in a state that was read while every key was
+// managed may have been set elsewhere, so they are not deleted when a service
+// switches to only managing its listed keys.
+func NewListedEnv(stateIgnoreUnmanaged, planIgnoreUnmanaged types.Bool, stateEVs, planEVs map[string]EnvVarModel, stateSFs, planSFs map[string]SecretFileModel) *ListedEnvStateAndPlan {
+	if !planIgnoreUnmanaged.ValueBool() {
+		return nil
+	}
+
+	env := &ListedEnvStateAndPlan{PlanEnvVars: planEVs, PlanSecretFiles: planSFs}
+	if stateIgnoreUnmanaged.ValueBool() {
+		env.StateEnvVars = stateEVs
+		env.StateSecretFiles = stateSFs
+	}
+	return env
+}
+
+// EnvVarChanged reports whether an env var has to be sent again. Write-only
+// values are only sent when their version changes.
+func EnvVarChanged(state, plan EnvVarModel) bool {
+	if plan.IsWriteOnly() || state.IsWriteOnly() {
+		return !state.ValueWOVersion.Equal(plan.ValueWOVersion)
+	}
+	return !plan.GenerateValue.ValueBool() && !state.Value.Equal(plan.Value)
+}
+
+// SecretFileChanged reports whether a secret file has to be sent again.
+// Write-only contents are only sent when their version changes.
+func SecretFileChanged(state, plan SecretFileModel) bool {
+	if plan.IsWriteOnly() || state.IsWriteOnly() {
+		return !state.ContentWOVersion.Equal(plan.ContentWOVersion)
+	}
+	return !state.Content.Equal(plan.Content)
+}
+
+// ListedEnvVars drops the env vars that are not listed in keys.
+func ListedEnvVars(evs *[]client.EnvVarWithCursor, keys map[string]EnvVarModel) *[]client.EnvVarWithCursor {
+	if evs == nil {
+		return nil
+	}
+
+	var res []client.EnvVarWithCursor
+	for _, ev := range *evs {
+		if _, ok := keys[ev.EnvVar.Key]; ok {
+			res = append(res, ev)
+		}
+	}
+	return &res
+}
+
+// ListedSecretFiles drops the secret files that are not listed in names.
+func ListedSecretFiles(sfs *[]client.SecretFileWithCursor, names map[string]SecretFileModel) *[]client.SecretFileWithCursor {
+	if sfs == nil {
+		return nil
+	}
+
+	var res []client.SecretFileWithCursor
+	for _, sf := range *sfs {
+		if _, ok := names[sf.SecretFile.Name]; ok {
+			res = append(res, sf)
+		}
+	}
+	return &res
+}
+
+func GetServiceEnvVar(ctx context.Context, apiClient *client.ClientWithResponses, serviceID, key string) (*client.EnvVar, error) {
+	var res client.EnvVar
+	err := Get(func() (*http.Response, error) {
+		return apiClient.RetrieveEnvVar(ctx, serviceID, key)
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpsertServiceEnvVar adds or updates a single env var without touching the
+// other env vars of the service.
+func UpsertServiceEnvVar(ctx context.Context, apiClient *client.ClientWithResponses, serviceID, key string, ev EnvVarModel) (*client.EnvVar, error) {
+	body, err := EnvVarAddUpdateToClient(key, ev)
+	if err != nil {
+		return nil, err
+	}
+
+	var res client.EnvVar
+	err = Update(func() (*http.Response, error) {
+		return apiClient.UpdateEnvVar(ctx, serviceID, key, *body)
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not update env var %s: %w", key, err)
+	}
+	return &res, nil
+}
+
+func DeleteServiceEnvVar(ctx context.Context, apiClient *client.ClientWithResponses, serviceID, key string) error {
+	err := Delete(func() (*http.Response, error) {
+		return apiClient.DeleteEnvVar(ctx, serviceID, key)
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete env var %s: %w", key, err)
+	}
+	return nil
+}
+
+func GetServiceSecretFile(ctx context.Context, apiClient *client.ClientWithResponses, serviceID, name string) (*client.SecretFile, error) {
+	var res client.SecretFile
+	err := Get(func() (*http.Response, error) {
+		return apiClient.RetrieveSecretFile(ctx, serviceID, name)
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpsertServiceSecretFile adds or updates a single secret file without
+// touching the other secret files of the service.
+func UpsertServiceSecretFile(ctx context.Context, apiClient *client.ClientWithResponses, serviceID, name, content string) (*client.SecretFile, error) {
+	var res client.SecretFile
+	err := Update(func() (*http.Response, error) {
+		return apiClient.AddOrUpdateSecretFile(ctx, serviceID, name, client.AddOrUpdateSecretFileJSONRequestBody{
+			Content: &content,
+		})
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not update secret file %s: %w", name, err)
+	}
+	return &res, nil
+}
+
+func DeleteServiceSecretFile(ctx context.Context, apiClient *client.ClientWithResponses, serviceID, name string) error {
+	err := Delete(func() (*http.Response, error) {
+		return apiClient.DeleteSecretFile(ctx, serviceID, name)
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete secret file %s: %w", name, err)
+	}
+	return nil
+}
+
+// updateListedEnv writes the listed env vars and secret files that changed,
+// deletes the ones that are no longer listed and returns the listed keys as
+// they are now.
+func updateListedEnv(ctx context.Context, apiClient *client.ClientWithResponses, serviceID string, env ListedEnvStateAndPlan) (*[]client.EnvVarWithCursor, *[]client.SecretFileWithCursor, error) {
+	for k, v := range env.PlanEnvVars {
+		if existing, ok := env.StateEnvVars[k]; ok && !EnvVarChanged(existing, v) {
+			continue
+		}
+		if _, err := UpsertServiceEnvVar(ctx, apiClient, serviceID, k, v); err != nil {
+			return nil, nil, err
+		}
+	}
+	for k := range env.StateEnvVars {
+		if _, ok := env.PlanEnvVars[k]; !ok {
+			if err := DeleteServiceEnvVar(ctx, apiClient, serviceID, k); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	for k, v := range env.PlanSecretFiles {
+		if existing, ok := env.StateSecretFiles[k]; ok && !SecretFileChanged(existing, v) {
+			continue
+		}
+		if _, err := UpsertServiceSecretFile(ctx, apiClient, serviceID, k, v.ContentValue()); err != nil {
+			return nil, nil, err
+		}
+	}
+	for k := range env.StateSecretFiles {
+		if _, ok := env.PlanSecretFiles[k]; !ok {
+			if err := DeleteServiceSecretFile(ctx, apiClient, serviceID, k); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	envVars, err := getEnvVars(ctx, apiClient, serviceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get env vars: %w", err)
+	}
+
+	secretFiles, err := getSecretFiles(ctx, apiClient, serviceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get secret files: %w", err)
+	}
+
+	return ListedEnvVars(envVars, env.PlanEnvVars), ListedSecretFiles(secretFiles, env.PlanSecretFiles), nil
+}
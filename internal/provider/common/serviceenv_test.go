@@ -0,0 +1,62 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+func TestNewListedEnv(t *testing.T) {
+	stateEVs := map[string]common.EnvVarModel{"OLD": {Value: types.StringValue("old")}}
+	planEVs := map[string]common.EnvVarModel{"NEW": {Value: types.StringValue("new")}}
+
+	t.Run("it returns nil when every key is managed", func(t *testing.T) {
+		env := common.NewListedEnv(types.BoolValue(true), types.BoolNull(), stateEVs, planEVs, nil, nil)
+		assert.Nil(t, env)
+	})
+
+	t.Run("it keeps the state when only listed keys were already managed", func(t *testing.T) {
+		env := common.NewListedEnv(types.BoolValue(true), types.BoolValue(true), stateEVs, planEVs, nil, nil)
+		require.NotNil(t, env)
+		assert.Equal(t, stateEVs, env.StateEnvVars)
+		assert.Equal(t, planEVs, env.PlanEnvVars)
+	})
+
+	t.Run("it drops the state when switching to listed keys", func(t *testing.T) {
+		env := common.NewListedEnv(types.BoolValue(false), types.BoolValue(true), stateEVs, planEVs, nil, nil)
+		require.NotNil(t, env)
+		assert.Nil(t, env.StateEnvVars)
+		assert.Equal(t, planEVs, env.PlanEnvVars)
+	})
+}
+
+func TestListedEnvVars(t *testing.T) {
+	evs := []client.EnvVarWithCursor{
+		{EnvVar: client.EnvVar{Key: "LISTED", Value: "a"}},
+		{EnvVar: client.EnvVar{Key: "UNMANAGED", Value: "b"}},
+	}
+
+	listed := common.ListedEnvVars(&evs, map[string]common.EnvVarModel{"LISTED": {}})
+	require.NotNil(t, listed)
+	require.Len(t, *listed, 1)
+	assert.Equal(t, "LISTED", (*listed)[0].EnvVar.Key)
+
+	assert.Nil(t, common.ListedEnvVars(nil, map[string]common.EnvVarModel{"LISTED": {}}))
+}
+
+func TestListedSecretFiles(t *testing.T) {
+	sfs := []client.SecretFileWithCursor{
+		{SecretFile: client.SecretFile{Name: "listed.txt"}},
+		{SecretFile: client.SecretFile{Name: "unmanaged.txt"}},
+	}
+
+	listed := common.ListedSecretFiles(&sfs, map[string]common.SecretFileModel{"listed.txt": {}})
+	require.NotNil(t, listed)
+	require.Len(t, *listed, 1)
+	assert.Equal(t, "listed.txt", (*listed)[0].SecretFile.Name)
+}
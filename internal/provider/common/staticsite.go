@@ -16,6 +16,7 @@ type WrappedStaticSite struct {
 	Headers              *[]client.Header
 	NotificationOverride *notifications.NotificationOverride
 	Routes               *[]client.Route
+	DeployID             *string
 }
 
 type UpdateStaticSiteReq struct {
@@ -24,9 +25,13 @@ type UpdateStaticSiteReq struct {
 	CustomDomains        CustomDomainStateAndPlan
 	EnvironmentID        *EnvironmentIDStateAndPlan
 	EnvVars              client.EnvVarInputArray
+	ListedEnv            *ListedEnvStateAndPlan
 	Headers              []client.HeaderInput
 	NotificationOverride *notifications.NotificationServiceOverridePATCH
 	Routes               []client.RoutePut
+
+	OnUpdate              UpdateStrategy
+	CancelPreviousDeploys bool
 }
 
 func WrapStaticSite(ctx context.Context, apiClient *client.ClientWithResponses, service *client.Service) (*WrappedStaticSite, error) {
@@ -56,14 +61,18 @@ func WrapStaticSite(ctx context.Context, apiClient *client.ClientWithResponses,
 
 }
 
-func UpdateStaticSite(ctx context.Context, apiClient *client.ClientWithResponses, skipDeploy bool, req UpdateStaticSiteReq) (*WrappedStaticSite, error) {
-	wrappedService, err := UpdateService(ctx, apiClient, skipDeploy, UpdateServiceReq{
+func UpdateStaticSite(ctx context.Context, apiClient *client.ClientWithResponses, req UpdateStaticSiteReq) (*WrappedStaticSite, error) {
+	wrappedService, err := UpdateService(ctx, apiClient, UpdateServiceReq{
 		ServiceID:            req.ServiceID,
 		Service:              req.Service,
 		CustomDomains:        req.CustomDomains,
 		EnvVars:              req.EnvVars,
+		ListedEnv:            req.ListedEnv,
 		EnvironmentID:        req.EnvironmentID,
 		NotificationOverride: req.NotificationOverride,
+
+		OnUpdate:              req.OnUpdate,
+		CancelPreviousDeploys: req.CancelPreviousDeploys,
 	}, ServiceTypeStaticSite)
 	if err != nil {
 		return nil, err
@@ -92,6 +101,7 @@ func UpdateStaticSite(ctx context.Context, apiClient *client.ClientWithResponses
 		Headers:              &headers,
 		NotificationOverride: wrappedService.NotificationOverride,
 		Routes:               &routes,
+		DeployID:             wrappedService.DeployID,
 	}, nil
 }
 
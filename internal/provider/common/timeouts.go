@@ -0,0 +1,35 @@
+package common
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultServiceTimeout bounds creating and updating a service. Waiting
+	// for a deploy may take up to the build (2 hour limit), pre deploy
+	// command (30 minute limit), and deploy (15 minute limit).
+	DefaultServiceTimeout = 3 * time.Hour
+
+	// DefaultDatastoreTimeout bounds creating and updating Postgres, Key Value
+	// and Redis instances.
+	DefaultDatastoreTimeout = 15 * time.Minute
+
+	// DefaultDeleteTimeout bounds deleting a resource.
+	DefaultDeleteTimeout = 30 * time.Minute
+)
+
+// TimeoutError is returned when an operation times out while waiting for
+// Render.
+type TimeoutError struct {
+	Timeout time.Duration
+	// Pending describes what was still pending, if known.
+	Pending string
+}
+
+func (e *TimeoutError) Error() string {
+	if e.Pending == "" {
+		return fmt.Sprintf("timed out after %s", e.Timeout)
+	}
+	return fmt.Sprintf("timed out after %s %s", e.Timeout, e.Pending)
+}
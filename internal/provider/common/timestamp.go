@@ -0,0 +1,27 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TimeFromString parses an optional RFC 3339 attribute. Null and unknown values
+// return nil so the API falls back to its own default.
+func TimeFromString(s types.String) (*time.Time, error) {
+	if s.IsNull() || s.IsUnknown() || s.ValueString() == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp %q: %w", s.ValueString(), err)
+	}
+	return &t, nil
+}
+
+// StringFromTime formats a time in RFC 3339, the same format the API emits.
+func StringFromTime(t time.Time) types.String {
+	return types.StringValue(t.Format(time.RFC3339))
+}
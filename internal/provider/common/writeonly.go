@@ -0,0 +1,58 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Write-only attributes are always null in the plan and state, so their values
+// have to be read from the config before they are sent to the API.
+
+// WriteOnlyString reads a write-only string attribute from the config.
+func WriteOnlyString(ctx context.Context, config tfsdk.Config, p path.Path) (types.String, diag.Diagnostics) {
+	var value types.String
+	diags := config.GetAttribute(ctx, p, &value)
+	return value, diags
+}
+
+// EnvVarsWithWriteOnlyValues copies value_wo from the config into the planned
+// env vars.
+func EnvVarsWithWriteOnlyValues(ctx context.Context, config tfsdk.Config, evs map[string]EnvVarModel) (map[string]EnvVarModel, diag.Diagnostics) {
+	var configEVs map[string]EnvVarModel
+	diags := config.GetAttribute(ctx, path.Root("env_vars"), &configEVs)
+	if diags.HasError() {
+		return evs, diags
+	}
+
+	for k, ev := range evs {
+		if configEV, ok := configEVs[k]; ok {
+			ev.ValueWO = configEV.ValueWO
+			evs[k] = ev
+		}
+	}
+
+	return evs, diags
+}
+
+// SecretFilesWithWriteOnlyContent copies content_wo from the config into the
+// planned secret files.
+func SecretFilesWithWriteOnlyContent(ctx context.Context, config tfsdk.Config, sfs map[string]SecretFileModel) (map[string]SecretFileModel, diag.Diagnostics) {
+	var configSFs map[string]SecretFileModel
+	diags := config.GetAttribute(ctx, path.Root("secret_files"), &configSFs)
+	if diags.HasError() {
+		return sfs, diags
+	}
+
+	for k, sf := range sfs {
+		if configSF, ok := configSFs[k]; ok {
+			sf.ContentWO = configSF.ContentWO
+			sfs[k] = sf
+		}
+	}
+
+	return sfs, diags
+}
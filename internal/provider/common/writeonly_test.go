@@ -0,0 +1,63 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+func TestWriteOnlyEnvVars(t *testing.T) {
+	t.Run("it sends the write-only value", func(t *testing.T) {
+		input, err := common.EnvVarToClient("API_KEY", common.EnvVarModel{
+			Value:          types.StringUnknown(),
+			ValueWO:        types.StringValue("from-vault"),
+			ValueWOVersion: types.Int64Value(1),
+			GenerateValue:  types.BoolValue(false),
+		})
+		require.NoError(t, err)
+
+		kv, err := input.AsEnvVarKeyValue()
+		require.NoError(t, err)
+		assert.Equal(t, "from-vault", kv.Value)
+	})
+
+	t.Run("it keeps write-only values out of state", func(t *testing.T) {
+		evs := common.EnvVarsFromClient(&[]client.EnvVar{
+			{Key: "API_KEY", Value: "from-vault"},
+			{Key: "PORT", Value: "8080"},
+		}, map[string]common.EnvVarModel{
+			"API_KEY": {ValueWOVersion: types.Int64Value(2), ValueWO: types.StringNull()},
+		})
+
+		assert.True(t, evs["API_KEY"].Value.IsNull())
+		assert.True(t, evs["API_KEY"].ValueWO.IsNull())
+		assert.Equal(t, int64(2), evs["API_KEY"].ValueWOVersion.ValueInt64())
+		assert.Equal(t, "8080", evs["PORT"].Value.ValueString())
+		assert.True(t, evs["PORT"].ValueWOVersion.IsNull())
+	})
+}
+
+func TestWriteOnlySecretFiles(t *testing.T) {
+	sfs := map[string]common.SecretFileModel{
+		"cert.pem": {
+			Content:          types.StringNull(),
+			ContentWO:        types.StringValue("-----BEGIN CERTIFICATE-----"),
+			ContentWOVersion: types.Int64Value(1),
+		},
+	}
+
+	inputs := common.SecretFilesToClient(sfs)
+	require.Len(t, inputs, 1)
+	assert.Equal(t, "-----BEGIN CERTIFICATE-----", inputs[0].Content)
+
+	state := common.SecretFilesFromClient(&[]client.SecretFile{
+		{Name: "cert.pem", Content: "-----BEGIN CERTIFICATE-----"},
+	}, sfs)
+	assert.True(t, state["cert.pem"].Content.IsNull())
+	assert.Equal(t, int64(1), state["cert.pem"].ContentWOVersion.ValueInt64())
+}
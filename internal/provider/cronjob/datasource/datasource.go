@@ -51,20 +51,33 @@ func (d *cronJobSource) Schema(ctx context.Context, _ datasource.SchemaRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (d *cronJobSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan cronJob.CronJobModel
+	var plan cronJob.CronJobDataSourceModel
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, d.client, d.ownerID, client.CronJob, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find cron job", err.Error())
+		return
+	}
+	plan.Id = id
+
 	service, err := common.GetWrappedService(ctx, d.client, plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get cron job", err.Error())
 		return
 	}
 
-	cronJobModel, err := cronJob.ModelForServiceResult(service, plan, resp.Diagnostics)
+	cronJobModel, err := cronJob.DataSourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to apply service result to model", err.Error())
 		return
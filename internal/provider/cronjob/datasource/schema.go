@@ -12,10 +12,10 @@ func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Cron Job resource.",
 		Attributes: map[string]schema.Attribute{
-			"id":                    datasource.ServiceID,
+			"id":                    datasource.LookupID("cron job"),
 			"runtime_source":        datasource.RuntimeSource,
-			"environment_id":        datasource.ResourceEnvironmentID,
-			"name":                  datasource.ServiceName,
+			"environment_id":        datasource.LookupEnvironmentID,
+			"name":                  datasource.LookupName("cron job"),
 			"slug":                  datasource.Slug,
 			"plan":                  datasource.Plan,
 			"region":                datasource.Region,
@@ -1,6 +1,7 @@
 package cronJob
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -8,23 +9,84 @@ import (
 )
 
 type CronJobModel struct {
-	Id            types.String                      `tfsdk:"id"`
-	RuntimeSource *common.RuntimeSourceModel        `tfsdk:"runtime_source"`
-	EnvironmentID types.String                      `tfsdk:"environment_id"`
-	Name          types.String                      `tfsdk:"name"`
-	Slug          types.String                      `tfsdk:"slug"`
-	Plan          types.String                      `tfsdk:"plan"`
-	Region        types.String                      `tfsdk:"region"`
-	RootDirectory types.String                      `tfsdk:"root_directory"`
-	Schedule      types.String                      `tfsdk:"schedule"`
-	StartCommand  types.String                      `tfsdk:"start_command"`
-	EnvVars       map[string]common.EnvVarModel     `tfsdk:"env_vars"`
-	SecretFiles   map[string]common.SecretFileModel `tfsdk:"secret_files"`
+	Id            types.String               `tfsdk:"id"`
+	RuntimeSource *common.RuntimeSourceModel `tfsdk:"runtime_source"`
+	EnvironmentID types.String               `tfsdk:"environment_id"`
+	Name          types.String               `tfsdk:"name"`
+	Slug          types.String               `tfsdk:"slug"`
+	Plan          types.String               `tfsdk:"plan"`
+	Region        types.String               `tfsdk:"region"`
+	RootDirectory types.String               `tfsdk:"root_directory"`
+	Schedule      types.String               `tfsdk:"schedule"`
+	StartCommand  types.String               `tfsdk:"start_command"`
 
 	NotificationOverride types.Object `tfsdk:"notification_override"`
 	LogStreamOverride    types.Object `tfsdk:"log_stream_override"`
 }
 
+// CronJobResourceModel is CronJobModel plus the env vars as the resource
+// accepts them and the settings that only affect how the resource applies
+// changes.
+type CronJobResourceModel struct {
+	CronJobModel
+
+	EnvVars     map[string]common.EnvVarModel     `tfsdk:"env_vars"`
+	SecretFiles map[string]common.SecretFileModel `tfsdk:"secret_files"`
+
+	IgnoreUnmanagedEnvVars types.Bool              `tfsdk:"ignore_unmanaged_env_vars"`
+	DeployWait             *common.DeployWaitModel `tfsdk:"deploy_wait"`
+	OnUpdate               types.String            `tfsdk:"on_update"`
+	Timeouts               timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// CronJobDataSourceModel is the state of the render_cron_job data source. Env
+// vars and secret files are read without value_wo or content_wo.
+type CronJobDataSourceModel struct {
+	CronJobModel
+
+	EnvVars     map[string]common.EnvVarDataSourceModel     `tfsdk:"env_vars"`
+	SecretFiles map[string]common.SecretFileDataSourceModel `tfsdk:"secret_files"`
+}
+
+// ResourceModelForServiceResult builds the state of the render_cron_job resource.
+func ResourceModelForServiceResult(service *common.WrappedService, plan CronJobResourceModel, diags diag.Diagnostics) (*CronJobResourceModel, error) {
+	model, err := ModelForServiceResult(service, plan.CronJobModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	envVars := service.EnvVars
+	secretFiles := service.SecretFiles
+	if plan.IgnoreUnmanagedEnvVars.ValueBool() {
+		envVars = common.ListedEnvVars(envVars, plan.EnvVars)
+		secretFiles = common.ListedSecretFiles(secretFiles, plan.SecretFiles)
+	}
+
+	return &CronJobResourceModel{
+		CronJobModel:           *model,
+		EnvVars:                common.EnvVarsFromClientCursors(envVars, plan.EnvVars),
+		SecretFiles:            common.SecretFilesFromClientCursors(secretFiles, plan.SecretFiles),
+		IgnoreUnmanagedEnvVars: plan.IgnoreUnmanagedEnvVars,
+		DeployWait:             plan.DeployWait,
+		OnUpdate:               plan.OnUpdate,
+		Timeouts:               plan.Timeouts,
+	}, nil
+}
+
+// DataSourceModelForServiceResult builds the state of the render_cron_job data source.
+func DataSourceModelForServiceResult(service *common.WrappedService, config CronJobDataSourceModel, diags diag.Diagnostics) (*CronJobDataSourceModel, error) {
+	model, err := ModelForServiceResult(service, config.CronJobModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CronJobDataSourceModel{
+		CronJobModel: *model,
+		EnvVars:      common.EnvVarsForDataSource(common.EnvVarsFromClientCursors(service.EnvVars, nil)),
+		SecretFiles:  common.SecretFilesForDataSource(common.SecretFilesFromClientCursors(service.SecretFiles, nil)),
+	}, nil
+}
+
 func ModelForServiceResult(service *common.WrappedService, plan CronJobModel, diags diag.Diagnostics) (*CronJobModel, error) {
 	details, err := service.ServiceDetails.AsCronJobDetails()
 	if err != nil {
@@ -40,8 +102,6 @@ func ModelForServiceResult(service *common.WrappedService, plan CronJobModel, di
 		Plan:                 types.StringValue(string(details.Plan)),
 		Region:               types.StringValue(string(details.Region)),
 		Schedule:             types.StringValue(details.Schedule),
-		EnvVars:              common.EnvVarsFromClientCursors(service.EnvVars, plan.EnvVars),
-		SecretFiles:          common.SecretFilesFromClientCursors(service.SecretFiles),
 		NotificationOverride: common.NotificationOverrideFromClient(service.NotificationOverride, diags),
 		LogStreamOverride:    common.LogStreamOverrideFromClient(service.LogStreamOverride, plan.LogStreamOverride, diags),
 	}
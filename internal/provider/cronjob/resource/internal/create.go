@@ -6,8 +6,8 @@ import (
 	"terraform-provider-render/internal/provider/cronjob"
 )
 
-func CreateServiceRequestFromModel(ownerID string, plan cronJob.CronJobModel) (client.CreateServiceJSONRequestBody, error) {
-	envSpecificDetails, err := buildEnvSpecificDetails(plan)
+func CreateServiceRequestFromModel(ownerID string, plan cronJob.CronJobResourceModel) (client.CreateServiceJSONRequestBody, error) {
+	envSpecificDetails, err := buildEnvSpecificDetails(plan.CronJobModel)
 	if err != nil {
 		return client.CreateServiceJSONRequestBody{}, err
 	}
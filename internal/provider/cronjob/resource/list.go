@@ -0,0 +1,44 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/types/listresource"
+)
+
+var _ list.ListResourceWithConfigure = &cronJobResource{}
+
+// NewCronJobListResource is a helper function to simplify the provider implementation.
+func NewCronJobListResource() list.ListResource {
+	return &cronJobResource{}
+}
+
+func (r *cronJobResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listresource.Schema("Lists the cron jobs in a Render workspace.", true)
+}
+
+// List streams the cron jobs owned by the workspace.
+func (r *cronJobResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ownerID, diags := common.ListOwnerID(ctx, req.Config, r.ownerID)
+	environmentIDs, envDiags := common.ListEnvironmentIDs(ctx, req.Config)
+	diags.Append(envDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := common.ListServices(ctx, r.client, ownerID, client.CronJob, common.ListFilter{EnvironmentIDs: environmentIDs}, req.Limit)
+	if err != nil {
+		diags.AddError("Unable to list cron jobs", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, r, ownerID, items, func(s client.Service) (string, string) {
+		return s.Id, s.Name
+	})
+}
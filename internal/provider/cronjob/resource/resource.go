@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-render/internal/client"
@@ -12,6 +11,7 @@ import (
 	cronJob "terraform-provider-render/internal/provider/cronjob"
 	"terraform-provider-render/internal/provider/cronjob/resource/internal"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 	resourcecommon "terraform-provider-render/internal/provider/types/resource"
 )
 
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                     = &cronJobResource{}
 	_ resource.ResourceWithConfigure        = &cronJobResource{}
 	_ resource.ResourceWithImportState      = &cronJobResource{}
+	_ resource.ResourceWithIdentity         = &cronJobResource{}
 	_ resource.ResourceWithConfigValidators = &cronJobResource{}
 )
 
@@ -32,6 +33,8 @@ func NewCronJobResource() resource.Resource {
 type cronJobResource struct {
 	client                       *client.ClientWithResponses
 	ownerID                      string
+	poller                       *common.Poller
+	waitForDeployCompletion      bool
 	skipDeployAfterServiceUpdate bool
 }
 
@@ -44,6 +47,8 @@ func (r *cronJobResource) Configure(_ context.Context, req resource.ConfigureReq
 
 	r.client = data.Client
 	r.ownerID = data.OwnerID
+	r.poller = data.Poller
+	r.waitForDeployCompletion = data.WaitForDeployCompletion
 	r.skipDeployAfterServiceUpdate = data.SkipDeployAfterServiceUpdate
 }
 
@@ -57,15 +62,38 @@ func (r *cronJobResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 	resp.Schema = Schema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *cronJobResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *cronJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan cronJob.CronJobModel
+	var plan cronJob.CronJobResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceDetails, err := internal.CreateServiceRequestFromModel(r.ownerID, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -74,7 +102,7 @@ func (r *cronJobResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	service, err := common.CreateService(ctx, r.client, common.CreateServiceReq{
+	service, err := common.CreateService(createCtx, r.client, common.CreateServiceReq{
 		Service:              serviceDetails,
 		EnvironmentID:        plan.EnvironmentID.ValueStringPointer(),
 		NotificationOverride: plan.NotificationOverride,
@@ -86,7 +114,7 @@ func (r *cronJobResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	model, err := cronJob.ModelForServiceResult(service, plan, resp.Diagnostics)
+	model, err := cronJob.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating cron job", "Could not create cron job, unexpected error: "+err.Error(),
@@ -97,11 +125,24 @@ func (r *cronJobResource) Create(ctx context.Context, req resource.CreateRequest
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *model)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
+	if resp.Diagnostics.HasError() || !deployWait.ShouldWait() {
+		return
+	}
+
+	err = common.WaitForService(createCtx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cron job",
+			"Service never started: "+err.Error(),
+		)
+		return
+	}
 }
 
 // Read resource information.
 func (r *cronJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state cronJob.CronJobModel
+	var state cronJob.CronJobResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -121,7 +162,7 @@ func (r *cronJobResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	cronJobModel, err := cronJob.ModelForServiceResult(service, state, resp.Diagnostics)
+	cronJobModel, err := cronJob.ResourceModelForServiceResult(service, state, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service", "Could not read service, unexpected error: "+err.Error(),
@@ -130,26 +171,52 @@ func (r *cronJobResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, cronJobModel)
+	diags = resp.State.Set(ctx, *cronJobModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *cronJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan cronJob.CronJobModel
+	var plan cronJob.CronJobResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state cronJob.CronJobModel
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+	onUpdate, err := common.UpdateStrategyForPlan(req.State, req.Plan, plan.OnUpdate, r.skipDeployAfterServiceUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service", "Could not determine how to apply the update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state cronJob.CronJobResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.UpdateServiceRequestFromModel(plan, r.ownerID)
+	serviceDetails, err := internal.UpdateServiceRequestFromModel(plan.CronJobModel, r.ownerID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service", "Could not update service, unexpected error: "+err.Error(),
@@ -173,11 +240,12 @@ func (r *cronJobResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	service, err := common.UpdateService(ctx, r.client, r.skipDeployAfterServiceUpdate, common.UpdateServiceReq{
+	service, err := common.UpdateService(ctx, r.client, common.UpdateServiceReq{
 		ServiceID:   plan.Id.ValueString(),
 		Service:     serviceDetails,
 		EnvVars:     evs,
 		SecretFiles: common.SecretFilesToClient(plan.SecretFiles),
+		ListedEnv:   common.NewListedEnv(state.IgnoreUnmanagedEnvVars, plan.IgnoreUnmanagedEnvVars, state.EnvVars, plan.EnvVars, state.SecretFiles, plan.SecretFiles),
 		EnvironmentID: &common.EnvironmentIDStateAndPlan{
 			State: state.EnvironmentID.ValueStringPointer(),
 			Plan:  plan.EnvironmentID.ValueStringPointer(),
@@ -187,6 +255,9 @@ func (r *cronJobResource) Update(ctx context.Context, req resource.UpdateRequest
 			State: state.LogStreamOverride,
 			Plan:  plan.LogStreamOverride,
 		},
+
+		OnUpdate:              onUpdate,
+		CancelPreviousDeploys: deployWait.CancelPreviousDeploys,
 	}, common.ServiceTypeCronJob)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -195,7 +266,7 @@ func (r *cronJobResource) Update(ctx context.Context, req resource.UpdateRequest
 		)
 		return
 	}
-	cronJobModel, err := cronJob.ModelForServiceResult(service, plan, resp.Diagnostics)
+	cronJobModel, err := cronJob.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service",
@@ -204,21 +275,39 @@ func (r *cronJobResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	diags = resp.State.Set(ctx, cronJobModel)
+	diags = resp.State.Set(ctx, *cronJobModel)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
+	if resp.Diagnostics.HasError() || !onUpdate.Deploys() || !deployWait.ShouldWait() {
+		return
+	}
+
+	err = common.WaitForService(ctx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service",
+			"Deploy never finished: "+err.Error(),
+		)
 		return
 	}
 }
 
 func (r *cronJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state cronJob.CronJobModel
+	var state cronJob.CronJobResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := common.Delete(func() (*http.Response, error) {
 		return r.client.DeleteService(ctx, state.Id.ValueString())
 	})
@@ -232,8 +321,15 @@ func (r *cronJobResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *cronJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is either the resource ID or a name reference such as
+	// name:my-api or env:<environment_id>/name:my-api.
+	common.ImportStateByName(ctx, req, resp, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, r.client, r.ownerID, client.CronJob, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
 }
 
 func (r *cronJobResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -12,20 +12,26 @@ func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides a Render Cron Job resource.",
 		Attributes: map[string]schema.Attribute{
-			"id":                    resource.ServiceID,
-			"runtime_source":        resource.RuntimeSource,
-			"environment_id":        resource.ResourceEnvironmentID,
-			"name":                  resource.ServiceName,
-			"slug":                  resource.Slug,
-			"plan":                  resource.Plan,
-			"region":                resource.Region,
-			"root_directory":        resource.RootDirectory,
-			"schedule":              resource.CronJobSchedule,
-			"start_command":         resource.StartCommand,
-			"env_vars":              resource.EnvVars,
-			"secret_files":          resource.SecretFiles,
-			"notification_override": resource.NotificationOverride,
-			"log_stream_override":   resource.LogStreamOverride,
+			"id":                        resource.ServiceID,
+			"runtime_source":            resource.RuntimeSource,
+			"environment_id":            resource.ResourceEnvironmentID,
+			"name":                      resource.ServiceName,
+			"slug":                      resource.Slug,
+			"plan":                      resource.Plan,
+			"region":                    resource.Region,
+			"root_directory":            resource.RootDirectory,
+			"schedule":                  resource.CronJobSchedule,
+			"start_command":             resource.StartCommand,
+			"env_vars":                  resource.EnvVars,
+			"ignore_unmanaged_env_vars": resource.IgnoreUnmanagedEnvVars,
+			"deploy_wait":               resource.DeployWait,
+			"on_update":                 resource.OnUpdate,
+			"secret_files":              resource.SecretFiles,
+			"notification_override":     resource.NotificationOverride,
+			"log_stream_override":       resource.LogStreamOverride,
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resource.Timeouts(ctx),
 		},
 	}
 }
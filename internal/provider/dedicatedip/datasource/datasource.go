@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-render/internal/client"
 	"terraform-provider-render/internal/provider/common"
@@ -22,7 +23,8 @@ func NewDedicatedIPDataSource() datasource.DataSource {
 }
 
 type dedicatedIPDataSource struct {
-	client *client.ClientWithResponses
+	client  *client.ClientWithResponses
+	ownerID string
 }
 
 func (d *dedicatedIPDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -31,6 +33,7 @@ func (d *dedicatedIPDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 	d.client = data.Client
+	d.ownerID = data.OwnerID
 }
 
 func (d *dedicatedIPDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,6 +51,19 @@ func (d *dedicatedIPDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	id, err := common.ResolveID(cfg.ID, cfg.Name, types.StringNull(), func(ref common.NameRef) (string, error) {
+		found, err := dedicatedip.FindByName(ctx, d.client, d.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find dedicated IP", err.Error())
+		return
+	}
+	cfg.ID = id
+
 	var fetched client.DedicatedIP
 	if err := common.Get(func() (*http.Response, error) {
 		return d.client.RetrieveDedicatedIp(ctx, cfg.ID.ValueString())
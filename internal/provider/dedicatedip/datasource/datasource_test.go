@@ -1,14 +1,27 @@
 package datasource_test
 
 import (
+	"net/http"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider"
 	th "terraform-provider-render/internal/provider/testhelpers"
 )
 
+const providerCfg = `
+provider "render" {
+  api_key = "some-api-key"
+  owner_id = "some-owner-id"
+}
+`
+
 // TestDedicatedIPDataSource creates a Dedicated IP via the resource and
 // then reads it back through the data source, asserting the computed
 // fields surface correctly.
@@ -36,3 +49,44 @@ func TestDedicatedIPDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestDedicatedIPDataSourceByName(t *testing.T) {
+	dedicatedIP := client.DedicatedIP{
+		Id:             "egs-123",
+		Name:           "outbound",
+		OwnerId:        "some-owner-id",
+		Region:         "oregon",
+		EnvironmentIds: []string{"evm-123"},
+		Ips:            []string{"203.0.113.10"},
+		Status:         client.RUNNING,
+	}
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/dedicated-ips": th.StaticResponse([]client.DedicatedIP{
+			dedicatedIP,
+			{Id: "egs-456", Name: "shared", OwnerId: "some-owner-id"},
+			{Id: "egs-789", Name: "shared", OwnerId: "some-owner-id"},
+		}),
+		"/dedicated-ips/egs-123": th.StaticResponse(dedicatedIP),
+	})
+	defer mockAPI.Close()
+
+	dataSourceName := "data.render_dedicated_ip.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(mockAPI.URL))()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerCfg + `data "render_dedicated_ip" "test" { name = "outbound" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "egs-123"),
+					resource.TestCheckResourceAttr(dataSourceName, "ips.0", "203.0.113.10"),
+				),
+			},
+			{
+				Config:      providerCfg + `data "render_dedicated_ip" "test" { name = "shared" }`,
+				ExpectError: regexp.MustCompile(`2 dedicated IPs are named "shared"`),
+			},
+		},
+	})
+}
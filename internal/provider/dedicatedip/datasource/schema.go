@@ -5,20 +5,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/provider/types/datasource"
 )
 
 func Schema(_ context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Dedicated IP.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "Unique identifier for this dedicated IP.",
-			},
-			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Descriptive name for this dedicated IP.",
-			},
+			"id":   datasource.LookupID("dedicated IP"),
+			"name": datasource.LookupName("dedicated IP"),
 			"description": schema.StringAttribute{
 				Computed:    true,
 				Description: "Free-form description for this dedicated IP.",
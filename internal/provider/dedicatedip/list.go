@@ -0,0 +1,45 @@
+package dedicatedip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// List lists the dedicated IPs owned by owner. The endpoint is not paginated.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string) ([]client.DedicatedIP, error) {
+	var res []client.DedicatedIP
+	err := common.Get(func() (*http.Response, error) {
+		return apiClient.ListDedicatedIps(ctx, &client.ListDedicatedIpsParams{OwnerId: owner})
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not list dedicated IPs: %w", err)
+	}
+	return res, nil
+}
+
+// FindByName returns the only dedicated IP named ref.Name. The API cannot
+// filter dedicated IPs by name, so every dedicated IP of the owner is listed.
+// When ref names an environment, only dedicated IPs that apply to it match.
+func FindByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, ref common.NameRef) (*client.DedicatedIP, error) {
+	items, err := List(ctx, apiClient, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.EnvironmentID != nil {
+		items = slices.DeleteFunc(items, func(d client.DedicatedIP) bool {
+			return !slices.Contains(d.EnvironmentIds, *ref.EnvironmentID)
+		})
+	}
+
+	d, err := common.FindByName("dedicated IP", ref, items, func(d client.DedicatedIP) (string, string) { return d.Id, d.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
@@ -52,15 +52,28 @@ func (d *envGroupDataSource) Schema(ctx context.Context, _ datasource.SchemaRequ
 
 // Read refreshes the Terraform state with the latest data.
 func (d *envGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan envgroup.EnvGroupModel
+	var plan envgroup.EnvGroupDataSourceModel
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := envgroup.FindByName(ctx, d.client, d.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find environment group", err.Error())
+		return
+	}
+	plan.Id = id
+
 	var envGroup client.EnvGroup
-	err := common.Get(func() (*http.Response, error) {
+	err = common.Get(func() (*http.Response, error) {
 		return d.client.RetrieveEnvGroup(ctx, plan.Id.ValueString())
 	}, &envGroup)
 	if err != nil {
@@ -68,5 +81,5 @@ func (d *envGroupDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	resp.State.Set(ctx, envgroup.ModelFromClient(&envGroup, nil))
+	resp.State.Set(ctx, envgroup.DataSourceModelFromClient(&envGroup))
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -13,6 +14,8 @@ import (
 
 	"terraform-provider-render/internal/client"
 	"terraform-provider-render/internal/provider"
+	"terraform-provider-render/internal/provider/common"
+	th "terraform-provider-render/internal/provider/testhelpers"
 )
 
 const providerCfg = `
@@ -68,3 +71,49 @@ func TestAccEnvGroupDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccEnvGroupDataSourceByName(t *testing.T) {
+	envGroup := client.EnvGroup{
+		Id:            "evg-123",
+		Name:          "shared-config",
+		EnvironmentId: common.From("evm-123"),
+		EnvVars:       []client.EnvVar{{Key: "key1", Value: "val1"}},
+		SecretFiles:   []client.SecretFile{},
+	}
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/env-groups": func(resp http.ResponseWriter, req *http.Request) {
+			if req.URL.Query().Get("environmentId") == "evm-123" {
+				th.StaticResponse([]client.EnvGroup{envGroup})(resp, req)
+				return
+			}
+			th.StaticResponse([]client.EnvGroup{envGroup, {Id: "evg-456", Name: "shared-config"}})(resp, req)
+		},
+		"/env-groups/evg-123": th.StaticResponse(envGroup),
+	})
+	defer mockAPI.Close()
+
+	var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(mockAPI.URL))()),
+	}
+
+	resourceName := "data.render_env_group.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerCfg + `data "render_env_group" "test" {
+  name           = "shared-config"
+  environment_id = "evm-123"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "evg-123"),
+					resource.TestCheckResourceAttr(resourceName, "env_vars.key1.value", "val1"),
+				),
+			},
+			{
+				Config:      providerCfg + `data "render_env_group" "test" { name = "shared-config" }`,
+				ExpectError: regexp.MustCompile(`2 environment groups are named "shared-config"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/envgroup"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &envGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &envGroupsDataSource{}
+)
+
+// NewEnvGroupsDataSource is a helper function to simplify the provider implementation.
+func NewEnvGroupsDataSource() datasource.DataSource {
+	return &envGroupsDataSource{}
+}
+
+// envGroupsDataSource is the data source implementation.
+type envGroupsDataSource struct {
+	client  *client.ClientWithResponses
+	ownerID string
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *envGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := rendertypes.ConfigureDatasource(req, resp)
+	if data == nil {
+		return
+	}
+
+	d.client = data.Client
+	d.ownerID = data.OwnerID
+}
+
+// Metadata returns the data source type name.
+func (d *envGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_groups"
+}
+
+// Schema defines the schema for the data source.
+func (d *envGroupsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = EnvGroupsDataSourceSchema(ctx)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *envGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config envgroup.EnvGroupsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := common.ListFilterFromSets(ctx, config.Names, config.EnvironmentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := envgroup.List(ctx, d.client, d.ownerID, filter, 0)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list environment groups", err.Error())
+		return
+	}
+	if envgroup.MayBeTruncated(items, 0) {
+		envgroup.AddTruncatedWarning(&resp.Diagnostics)
+	}
+
+	config.EnvGroups = make([]envgroup.EnvGroupSummaryModel, 0, len(items))
+	for _, item := range items {
+		model, diags := envgroup.EnvGroupSummaryModelFromClient(ctx, item)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config.EnvGroups = append(config.EnvGroups, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,59 @@
+package datasource_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider"
+	"terraform-provider-render/internal/provider/common"
+)
+
+func TestAccEnvGroupsDataSource(t *testing.T) {
+	fakeServer := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/env-groups", req.URL.Path)
+		assert.Equal(t, "evm-123", req.URL.Query().Get("environmentId"))
+
+		res, err := json.Marshal([]client.EnvGroupMeta{
+			{Id: "evg-1", Name: "shared", EnvironmentId: common.From("evm-123"), ServiceLinks: []client.EnvGroupLink{{Id: "srv-1"}}},
+			{Id: "evg-2", Name: "secrets", EnvironmentId: common.From("evm-123")},
+		})
+		require.NoError(t, err)
+
+		resp.Header().Set("Content-Type", "application/json")
+		_, err = resp.Write(res)
+		require.NoError(t, err)
+	}))
+	defer fakeServer.Close()
+
+	var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(fakeServer.URL))()),
+	}
+
+	resourceName := "data.render_env_groups.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerCfg + `data "render_env_groups" "test" { environment_ids = ["evm-123"] }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "env_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "env_groups.0.id", "evg-1"),
+					resource.TestCheckResourceAttr(resourceName, "env_groups.0.name", "shared"),
+					resource.TestCheckResourceAttr(resourceName, "env_groups.0.environment_id", "evm-123"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "env_groups.0.service_ids.*", "srv-1"),
+					resource.TestCheckResourceAttr(resourceName, "env_groups.1.id", "evg-2"),
+					resource.TestCheckResourceAttr(resourceName, "env_groups.1.service_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
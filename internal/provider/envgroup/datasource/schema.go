@@ -13,17 +13,9 @@ func EnvGroupDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Environment Group resource.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Required:            true,
-				Description:         "Unique identifier for this environment group",
-				MarkdownDescription: "Unique identifier for this environment group",
-			},
-			"name": schema.StringAttribute{
-				Computed:            true,
-				Description:         "Descriptive name for this environment group",
-				MarkdownDescription: "Descriptive name for this environment group",
-			},
-			"environment_id": datasource.EnvironmentID,
+			"id":             datasource.LookupID("environment group"),
+			"name":           datasource.LookupName("environment group"),
+			"environment_id": datasource.LookupEnvironmentID,
 			"env_vars":       datasource.EnvVars,
 			"secret_files":   datasource.SecretFiles,
 		},
@@ -47,3 +39,47 @@ func EnvGroupLinkDataSourceSchema(ctx context.Context) schema.Schema {
 		},
 	}
 }
+
+func EnvGroupsDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides every Render Environment Group in the workspace that matches the filters. At most 100 environment groups are returned because the API does not paginate them, with a warning when there may be more.",
+		MarkdownDescription: "Provides every Render Environment Group in the workspace that matches the filters. At most 100 environment groups are returned because the API does not paginate them, with a warning when there may be more.",
+		Attributes: map[string]schema.Attribute{
+			"names":           datasource.FilterNames("environment groups"),
+			"environment_ids": datasource.FilterEnvironmentIDs("environment groups"),
+			"env_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Environment groups matching the filters. Use the render_env_group data source to read their env vars and secret files.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the environment group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the environment group.",
+						},
+						"environment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment the environment group belongs to. Null when it is not in an environment.",
+						},
+						"service_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the services linked to the environment group.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the environment group was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the environment group was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
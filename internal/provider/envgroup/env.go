@@ -0,0 +1,98 @@
+package envgroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+func GetEnvVar(ctx context.Context, apiClient *client.ClientWithResponses, envGroupID, key string) (*client.EnvVar, error) {
+	var res client.EnvVar
+	err := common.Get(func() (*http.Response, error) {
+		return apiClient.RetrieveEnvGroupEnvVar(ctx, envGroupID, key)
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpsertEnvVar adds or updates a single env var without touching the other
+// keys of the environment group. The API returns the whole group, so the env
+// var is looked up in it to get generated values.
+func UpsertEnvVar(ctx context.Context, apiClient *client.ClientWithResponses, envGroupID, key string, ev common.EnvVarModel) (*client.EnvVar, error) {
+	body, err := common.EnvVarAddUpdateToClient(key, ev)
+	if err != nil {
+		return nil, err
+	}
+
+	var envGroup client.EnvGroup
+	err = common.Update(func() (*http.Response, error) {
+		return apiClient.UpdateEnvGroupEnvVar(ctx, envGroupID, key, *body)
+	}, &envGroup)
+	if err != nil {
+		return nil, fmt.Errorf("could not update env var %s: %w", key, err)
+	}
+
+	for _, res := range envGroup.EnvVars {
+		if res.Key == key {
+			return &res, nil
+		}
+	}
+	return nil, fmt.Errorf("env var %s not found in environment group %s after update", key, envGroupID)
+}
+
+func DeleteEnvVar(ctx context.Context, apiClient *client.ClientWithResponses, envGroupID, key string) error {
+	err := common.Delete(func() (*http.Response, error) {
+		return apiClient.DeleteEnvGroupEnvVar(ctx, envGroupID, key)
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete env var %s: %w", key, err)
+	}
+	return nil
+}
+
+func GetSecretFile(ctx context.Context, apiClient *client.ClientWithResponses, envGroupID, name string) (*client.SecretFile, error) {
+	var res client.SecretFile
+	err := common.Get(func() (*http.Response, error) {
+		return apiClient.RetrieveEnvGroupSecretFile(ctx, envGroupID, name)
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpsertSecretFile adds or updates a single secret file without touching the
+// other keys of the environment group.
+func UpsertSecretFile(ctx context.Context, apiClient *client.ClientWithResponses, envGroupID, name, content string) (*client.SecretFile, error) {
+	var envGroup client.EnvGroup
+	err := common.Update(func() (*http.Response, error) {
+		return apiClient.UpdateEnvGroupSecretFile(ctx, envGroupID, name, client.UpdateEnvGroupSecretFileJSONRequestBody{
+			Content: &content,
+		})
+	}, &envGroup)
+	if err != nil {
+		return nil, fmt.Errorf("could not update secret file %s: %w", name, err)
+	}
+
+	for _, res := range envGroup.SecretFiles {
+		if res.Name == name {
+			return &res, nil
+		}
+	}
+	return nil, fmt.Errorf("secret file %s not found in environment group %s after update", name, envGroupID)
+}
+
+func DeleteSecretFile(ctx context.Context, apiClient *client.ClientWithResponses, envGroupID, name string) error {
+	err := common.Delete(func() (*http.Response, error) {
+		return apiClient.DeleteEnvGroupSecretFile(ctx, envGroupID, name)
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete secret file %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package envgroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// PageSize is the most environment groups List returns.
+const PageSize = 100
+
+// List lists the environment groups owned by owner, optionally restricted to
+// environments. Environment groups are returned without cursors, so only a
+// single page can be fetched. Use MayBeTruncated to tell whether groups may be
+// missing from the result.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string, filter common.ListFilter, limit int64) ([]client.EnvGroupMeta, error) {
+	pageSize := PageSize
+	if limit > 0 && limit < int64(pageSize) {
+		pageSize = int(limit)
+	}
+
+	var res []client.EnvGroupMeta
+	err := common.Get(func() (*http.Response, error) {
+		return apiClient.ListEnvGroups(ctx, &client.ListEnvGroupsParams{
+			OwnerId:       common.From([]string{owner}),
+			Name:          filter.Names,
+			EnvironmentId: filter.EnvironmentIDs,
+			Limit:         &pageSize,
+		})
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not list environment groups: %w", err)
+	}
+
+	return res, nil
+}
+
+// MayBeTruncated reports whether List returned a full page while more than a
+// page was asked for, in which case there may be more environment groups than
+// were returned.
+func MayBeTruncated(items []client.EnvGroupMeta, limit int64) bool {
+	return len(items) >= PageSize && (limit <= 0 || limit > PageSize)
+}
+
+// AddTruncatedWarning warns that only the first page of environment groups
+// was listed.
+func AddTruncatedWarning(diags *diag.Diagnostics) {
+	diags.AddWarning(
+		"Environment groups may be missing",
+		fmt.Sprintf("Only the first %d environment groups were listed, as the Render API cannot page through environment groups. Filter by name or environment to list the others.", PageSize),
+	)
+}
+
+// FindByName returns the only environment group named ref.Name.
+func FindByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, ref common.NameRef) (*client.EnvGroupMeta, error) {
+	items, err := List(ctx, apiClient, owner, ref.Filter(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	eg, err := common.FindByName("environment group", ref, items, func(eg client.EnvGroupMeta) (string, string) { return eg.Id, eg.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &eg, nil
+}
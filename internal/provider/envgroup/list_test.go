@@ -0,0 +1,58 @@
+package envgroup_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/envgroup"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func envGroupPage(n int) []client.EnvGroupMeta {
+	page := make([]client.EnvGroupMeta, 0, n)
+	for i := range n {
+		page = append(page, client.EnvGroupMeta{Id: fmt.Sprintf("evg-%d", i), Name: fmt.Sprintf("group-%d", i)})
+	}
+	return page
+}
+
+func TestList(t *testing.T) {
+	count := 0
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/env-groups": func(resp http.ResponseWriter, req *http.Request) {
+			assert.Empty(t, req.URL.Query().Get("cursor"))
+			th.StaticResponse(envGroupPage(count))(resp, req)
+		},
+	})
+	defer mockAPI.Close()
+
+	c, err := client.NewClientWithResponses(mockAPI.URL)
+	require.NoError(t, err)
+
+	t.Run("it flags a full page as possibly truncated", func(t *testing.T) {
+		count = 100
+		items, err := envgroup.List(context.Background(), c, "own-123", common.ListFilter{}, 0)
+		require.NoError(t, err)
+
+		assert.Len(t, items, 100)
+		assert.True(t, envgroup.MayBeTruncated(items, 0))
+		assert.True(t, envgroup.MayBeTruncated(items, 150))
+		assert.False(t, envgroup.MayBeTruncated(items, 100), "a full page is all that was asked for")
+	})
+
+	t.Run("it does not flag a partial page", func(t *testing.T) {
+		count = 30
+		items, err := envgroup.List(context.Background(), c, "own-123", common.ListFilter{}, 0)
+		require.NoError(t, err)
+
+		assert.Len(t, items, 30)
+		assert.False(t, envgroup.MayBeTruncated(items, 0))
+	})
+}
@@ -1,6 +1,8 @@
 package envgroup
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,16 +17,58 @@ type EnvGroupModel struct {
 	EnvironmentID types.String                      `tfsdk:"environment_id"`
 	EnvVars       map[string]common.EnvVarModel     `tfsdk:"env_vars"`
 	SecretFiles   map[string]common.SecretFileModel `tfsdk:"secret_files"`
+
+	IgnoreUnmanagedEnvVars types.Bool `tfsdk:"ignore_unmanaged_env_vars"`
 }
 
-func ModelFromClient(envGroup *client.EnvGroup, planEnvVars map[string]common.EnvVarModel) EnvGroupModel {
+func ModelFromClient(envGroup *client.EnvGroup, planEnvVars map[string]common.EnvVarModel, planSecretFiles map[string]common.SecretFileModel) EnvGroupModel {
 	return EnvGroupModel{
 		Id:            types.StringValue(envGroup.Id),
 		Name:          types.StringValue(envGroup.Name),
 		EnvironmentID: types.StringPointerValue(envGroup.EnvironmentId),
 		EnvVars:       common.EnvVarsFromClient(&envGroup.EnvVars, planEnvVars),
-		SecretFiles:   common.SecretFilesFromClient(&envGroup.SecretFiles),
+		SecretFiles:   common.SecretFilesFromClient(&envGroup.SecretFiles, planSecretFiles),
+	}
+}
+
+// EnvGroupDataSourceModel is the state of the render_env_group data source,
+// which reads env vars and secret files without their write-only attributes.
+type EnvGroupDataSourceModel struct {
+	Id            types.String                                `tfsdk:"id"`
+	Name          types.String                                `tfsdk:"name"`
+	EnvironmentID types.String                                `tfsdk:"environment_id"`
+	EnvVars       map[string]common.EnvVarDataSourceModel     `tfsdk:"env_vars"`
+	SecretFiles   map[string]common.SecretFileDataSourceModel `tfsdk:"secret_files"`
+}
+
+func DataSourceModelFromClient(envGroup *client.EnvGroup) EnvGroupDataSourceModel {
+	return EnvGroupDataSourceModel{
+		Id:            types.StringValue(envGroup.Id),
+		Name:          types.StringValue(envGroup.Name),
+		EnvironmentID: types.StringPointerValue(envGroup.EnvironmentId),
+		EnvVars:       common.EnvVarsForDataSource(common.EnvVarsFromClient(&envGroup.EnvVars, nil)),
+		SecretFiles:   common.SecretFilesForDataSource(common.SecretFilesFromClient(&envGroup.SecretFiles, nil)),
+	}
+}
+
+// ListedOnly drops the env vars and secret files of an environment group that
+// are not listed in evs and sfs, so that keys owned by render_env_group_env_var
+// and render_env_group_secret_file do not show up as changes.
+func ListedOnly(envGroup *client.EnvGroup, evs map[string]common.EnvVarModel, sfs map[string]common.SecretFileModel) *client.EnvGroup {
+	listed := *envGroup
+	listed.EnvVars = nil
+	for _, ev := range envGroup.EnvVars {
+		if _, ok := evs[ev.Key]; ok {
+			listed.EnvVars = append(listed.EnvVars, ev)
+		}
+	}
+	listed.SecretFiles = nil
+	for _, sf := range envGroup.SecretFiles {
+		if _, ok := sfs[sf.Name]; ok {
+			listed.SecretFiles = append(listed.SecretFiles, sf)
+		}
 	}
+	return &listed
 }
 
 type EnvGroupLinkModel struct {
@@ -55,3 +99,119 @@ func LinkModelFromClient(envGroup *client.EnvGroup) (EnvGroupLinkModel, diag.Dia
 		ServiceIds: serviceIdsSet,
 	}, diags
 }
+
+type EnvGroupsModel struct {
+	EnvironmentIDs types.Set              `tfsdk:"environment_ids"`
+	Names          types.Set              `tfsdk:"names"`
+	EnvGroups      []EnvGroupSummaryModel `tfsdk:"env_groups"`
+}
+
+// EnvGroupSummaryModel summarizes an environment group without its env vars and
+// secret files. Use the render_env_group data source to read them.
+type EnvGroupSummaryModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	ServiceIDs    types.Set    `tfsdk:"service_ids"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+func EnvGroupSummaryModelFromClient(ctx context.Context, eg client.EnvGroupMeta) (EnvGroupSummaryModel, diag.Diagnostics) {
+	serviceIDs := make([]string, 0, len(eg.ServiceLinks))
+	for _, link := range eg.ServiceLinks {
+		serviceIDs = append(serviceIDs, link.Id)
+	}
+
+	ids, diags := types.SetValueFrom(ctx, types.StringType, serviceIDs)
+	return EnvGroupSummaryModel{
+		ID:            types.StringValue(eg.Id),
+		Name:          types.StringValue(eg.Name),
+		EnvironmentID: types.StringPointerValue(eg.EnvironmentId),
+		ServiceIDs:    ids,
+		CreatedAt:     common.StringFromTime(eg.CreatedAt),
+		UpdatedAt:     common.StringFromTime(eg.UpdatedAt),
+	}, diags
+}
+
+// EnvVarModel is a single env var of an environment group, managed separately
+// from the group's env_vars.
+type EnvVarModel struct {
+	ID             types.String `tfsdk:"id"`
+	EnvGroupID     types.String `tfsdk:"env_group_id"`
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	GenerateValue  types.Bool   `tfsdk:"generate_value"`
+}
+
+// EnvVar returns the value of the env var in the form shared with env_vars.
+func (m EnvVarModel) EnvVar() common.EnvVarModel {
+	return common.EnvVarModel{
+		Value:          m.Value,
+		ValueWO:        m.ValueWO,
+		ValueWOVersion: m.ValueWOVersion,
+		GenerateValue:  m.GenerateValue,
+	}
+}
+
+// EnvVarModelFromClient builds the state of an env var. generate_value and the
+// write-only version are not returned by the API, so they are kept from prior.
+func EnvVarModelFromClient(envGroupID string, ev client.EnvVar, prior EnvVarModel) EnvVarModel {
+	m := EnvVarModel{
+		ID:             types.StringValue(envGroupID + "/" + ev.Key),
+		EnvGroupID:     types.StringValue(envGroupID),
+		Key:            types.StringValue(ev.Key),
+		Value:          types.StringValue(ev.Value),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: prior.ValueWOVersion,
+		GenerateValue:  types.BoolValue(prior.GenerateValue.ValueBool()),
+	}
+
+	if prior.EnvVar().IsWriteOnly() {
+		m.Value = types.StringNull()
+	}
+
+	return m
+}
+
+// SecretFileModel is a single secret file of an environment group, managed
+// separately from the group's secret_files.
+type SecretFileModel struct {
+	ID               types.String `tfsdk:"id"`
+	EnvGroupID       types.String `tfsdk:"env_group_id"`
+	Name             types.String `tfsdk:"name"`
+	Content          types.String `tfsdk:"content"`
+	ContentWO        types.String `tfsdk:"content_wo"`
+	ContentWOVersion types.Int64  `tfsdk:"content_wo_version"`
+}
+
+// SecretFile returns the content of the secret file in the form shared with
+// secret_files.
+func (m SecretFileModel) SecretFile() common.SecretFileModel {
+	return common.SecretFileModel{
+		Content:          m.Content,
+		ContentWO:        m.ContentWO,
+		ContentWOVersion: m.ContentWOVersion,
+	}
+}
+
+// SecretFileModelFromClient builds the state of a secret file. The write-only
+// version is not returned by the API, so it is kept from prior.
+func SecretFileModelFromClient(envGroupID string, sf client.SecretFile, prior SecretFileModel) SecretFileModel {
+	m := SecretFileModel{
+		ID:               types.StringValue(envGroupID + "/" + sf.Name),
+		EnvGroupID:       types.StringValue(envGroupID),
+		Name:             types.StringValue(sf.Name),
+		Content:          types.StringValue(sf.Content),
+		ContentWO:        types.StringNull(),
+		ContentWOVersion: prior.ContentWOVersion,
+	}
+
+	if prior.SecretFile().IsWriteOnly() {
+		m.Content = types.StringNull()
+	}
+
+	return m
+}
@@ -0,0 +1,61 @@
+package envgroup_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/envgroup"
+)
+
+func TestListedOnly(t *testing.T) {
+	envGroup := &client.EnvGroup{
+		Id: "evg-123",
+		EnvVars: []client.EnvVar{
+			{Key: "LISTED", Value: "a"},
+			{Key: "UNMANAGED", Value: "b"},
+		},
+		SecretFiles: []client.SecretFile{
+			{Name: "listed.txt", Content: "c"},
+			{Name: "unmanaged.txt", Content: "d"},
+		},
+	}
+
+	listed := envgroup.ListedOnly(envGroup,
+		map[string]common.EnvVarModel{"LISTED": {}},
+		map[string]common.SecretFileModel{"listed.txt": {}},
+	)
+
+	assert.Equal(t, "evg-123", listed.Id)
+	assert.Equal(t, []client.EnvVar{{Key: "LISTED", Value: "a"}}, listed.EnvVars)
+	assert.Equal(t, []client.SecretFile{{Name: "listed.txt", Content: "c"}}, listed.SecretFiles)
+	assert.Len(t, envGroup.EnvVars, 2, "the original group is not modified")
+}
+
+func TestEnvVarModelFromClient(t *testing.T) {
+	t.Run("it keeps generate_value from the prior state", func(t *testing.T) {
+		prior := envgroup.EnvVarModel{GenerateValue: types.BoolValue(true)}
+
+		m := envgroup.EnvVarModelFromClient("evg-123", client.EnvVar{Key: "SECRET", Value: "generated"}, prior)
+
+		assert.Equal(t, "evg-123/SECRET", m.ID.ValueString())
+		assert.Equal(t, "generated", m.Value.ValueString())
+		assert.True(t, m.GenerateValue.ValueBool())
+	})
+
+	t.Run("it does not store write-only values", func(t *testing.T) {
+		prior := envgroup.EnvVarModel{
+			ValueWO:        types.StringValue("secret"),
+			ValueWOVersion: types.Int64Value(2),
+		}
+
+		m := envgroup.EnvVarModelFromClient("evg-123", client.EnvVar{Key: "TOKEN", Value: "secret"}, prior)
+
+		assert.True(t, m.Value.IsNull())
+		assert.True(t, m.ValueWO.IsNull())
+		assert.Equal(t, int64(2), m.ValueWOVersion.ValueInt64())
+	})
+}
@@ -0,0 +1,160 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/envgroup"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+var (
+	_ resource.Resource                = &envGroupEnvVarResource{}
+	_ resource.ResourceWithConfigure   = &envGroupEnvVarResource{}
+	_ resource.ResourceWithImportState = &envGroupEnvVarResource{}
+)
+
+func NewEnvGroupEnvVarResource() resource.Resource {
+	return &envGroupEnvVarResource{}
+}
+
+type envGroupEnvVarResource struct {
+	client *client.ClientWithResponses
+}
+
+func (r *envGroupEnvVarResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := rendertypes.ConfigureResource(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *envGroupEnvVarResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_group_env_var"
+}
+
+func (r *envGroupEnvVarResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = EnvGroupEnvVarResourceSchema(ctx)
+}
+
+func (r *envGroupEnvVarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan envgroup.EnvVarModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.upsert(ctx, req.Config, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating env var", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *envGroupEnvVarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state envgroup.EnvVarModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ev, err := envgroup.GetEnvVar(ctx, r.client, state.EnvGroupID.ValueString(), state.Key.ValueString())
+	if common.IsNotFoundErr(err) {
+		common.EmitNotFoundWarning(state.ID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading env var", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, envgroup.EnvVarModelFromClient(state.EnvGroupID.ValueString(), *ev, state))...)
+}
+
+func (r *envGroupEnvVarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan envgroup.EnvVarModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.upsert(ctx, req.Config, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating env var", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *envGroupEnvVarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state envgroup.EnvVarModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := envgroup.DeleteEnvVar(ctx, r.client, state.EnvGroupID.ValueString(), state.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting env var", err.Error())
+		return
+	}
+}
+
+// ImportState imports an env var from an ID of the form <env_group_id>/<key>.
+func (r *envGroupEnvVarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	envGroupID, key, err := parseChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("env_group_id"), envGroupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// upsert writes the planned value, reading value_wo from the config since it
+// is never part of the plan.
+func (r *envGroupEnvVarResource) upsert(ctx context.Context, config tfsdk.Config, plan envgroup.EnvVarModel, diags *diag.Diagnostics) (envgroup.EnvVarModel, error) {
+	valueWO, d := common.WriteOnlyString(ctx, config, path.Root("value_wo"))
+	diags.Append(d...)
+	if diags.HasError() {
+		return plan, nil
+	}
+	plan.ValueWO = valueWO
+
+	ev, err := envgroup.UpsertEnvVar(ctx, r.client, plan.EnvGroupID.ValueString(), plan.Key.ValueString(), plan.EnvVar())
+	if err != nil {
+		return plan, err
+	}
+
+	return envgroup.EnvVarModelFromClient(plan.EnvGroupID.ValueString(), *ev, plan), nil
+}
+
+// parseChildImportID splits an import ID of the form <env_group_id>/<id>, used
+// by resources that belong to an environment group.
+func parseChildImportID(id string) (envGroupID, childID string, err error) {
+	envGroupID, childID, ok := strings.Cut(id, "/")
+	if !ok || envGroupID == "" || childID == "" {
+		return "", "", fmt.Errorf("expected an import ID of the form <env_group_id>/<id>, got %q", id)
+	}
+	return envGroupID, childID, nil
+}
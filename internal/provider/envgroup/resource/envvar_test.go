@@ -0,0 +1,200 @@
+package resource_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+// envGroupServer fakes the environment group evg-1, whose keys are kept in
+// env.
+func envGroupServer(t *testing.T, env *th.FakeEnv) *httptest.Server {
+	envGroup := func() client.EnvGroup {
+		return client.EnvGroup{
+			Id:          "evg-1",
+			Name:        "shared",
+			OwnerId:     "some-owner-id",
+			CreatedAt:   time.Date(2025, 5, 8, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2025, 5, 8, 0, 0, 0, 0, time.UTC),
+			EnvVars:     env.EnvVars(),
+			SecretFiles: env.SecretFiles(),
+		}
+	}
+
+	handlers := env.Handlers("/env-groups/evg-1")
+	maps.Copy(handlers, map[string]http.HandlerFunc{
+		"/env-groups": func(resp http.ResponseWriter, req *http.Request) {
+			require.Equal(t, http.MethodPost, req.Method)
+
+			var body struct {
+				EnvVars     []client.EnvVarKeyValue  `json:"envVars"`
+				SecretFiles []client.SecretFileInput `json:"secretFiles"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			for _, ev := range body.EnvVars {
+				env.SetEnvVar(ev.Key, ev.Value)
+			}
+			for _, sf := range body.SecretFiles {
+				env.SetSecretFile(sf.Name, sf.Content)
+			}
+
+			resp.WriteHeader(http.StatusCreated)
+			th.StaticResponse(envGroup())(resp, req)
+		},
+		"/env-groups/evg-1": func(resp http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodDelete {
+				resp.WriteHeader(http.StatusNoContent)
+				return
+			}
+			th.StaticResponse(envGroup())(resp, req)
+		},
+	})
+
+	// Writing a single key returns the whole group.
+	for _, pattern := range []string{"/env-groups/evg-1/env-vars/[^/]+", "/env-groups/evg-1/secret-files/[^/]+"} {
+		next := handlers[pattern]
+		handlers[pattern] = func(resp http.ResponseWriter, req *http.Request) {
+			if req.Method != http.MethodPut {
+				next(resp, req)
+				return
+			}
+			next(httptest.NewRecorder(), req)
+			th.StaticResponse(envGroup())(resp, req)
+		}
+	}
+	return th.NewMockRenderAPI(handlers)
+}
+
+func envGroupEnvVarConfig(value string) string {
+	return providerCfg + fmt.Sprintf(`
+resource "render_env_group_env_var" "test" {
+  env_group_id = "evg-1"
+  key          = "LOG_LEVEL"
+  value        = %q
+}
+`, value)
+}
+
+func TestEnvGroupEnvVarResource(t *testing.T) {
+	env := th.NewFakeEnv()
+	env.SetEnvVar("OTHER", "kept")
+	fakeServer := envGroupServer(t, env)
+	resourceName := "render_env_group_env_var.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(fakeServer.URL))()),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			env.CheckNoEnvVar("LOG_LEVEL"),
+			env.CheckEnvVar("OTHER", "kept"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: envGroupEnvVarConfig("info"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "evg-1/LOG_LEVEL"),
+					resource.TestCheckResourceAttr(resourceName, "env_group_id", "evg-1"),
+					resource.TestCheckResourceAttr(resourceName, "key", "LOG_LEVEL"),
+					resource.TestCheckResourceAttr(resourceName, "value", "info"),
+					env.CheckEnvVar("LOG_LEVEL", "info"),
+				),
+			},
+			{
+				// Changed outside of Terraform, so that the refresh has to read
+				// the key again.
+				PreConfig: func() { env.SetEnvVar("LOG_LEVEL", "warn") },
+				Config:    envGroupEnvVarConfig("info"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: env.CheckEnvVar("LOG_LEVEL", "info"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "evg-1/LOG_LEVEL",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func envGroupWithChildrenConfig(dbURL string) string {
+	return providerCfg + fmt.Sprintf(`
+resource "render_env_group" "test" {
+  name = "shared"
+
+  ignore_unmanaged_env_vars = true
+  env_vars = {
+    DATABASE_URL = { value = %q }
+  }
+}
+
+resource "render_env_group_env_var" "test" {
+  env_group_id = render_env_group.test.id
+  key          = "API_KEY"
+  value        = "secret"
+}
+
+resource "render_env_group_secret_file" "test" {
+  env_group_id = render_env_group.test.id
+  name         = "config.json"
+  content      = "{}"
+}
+`, dbURL)
+}
+
+func TestEnvGroupChildResourcesWithUnmanagedEnvVarsIgnored(t *testing.T) {
+	env := th.NewFakeEnv()
+	fakeServer := envGroupServer(t, env)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(fakeServer.URL))()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: envGroupWithChildrenConfig("postgres://one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_env_group.test", "env_vars.%", "1"),
+					resource.TestCheckNoResourceAttr("render_env_group.test", "secret_files"),
+					env.CheckEnvVar("API_KEY", "secret"),
+					env.CheckSecretFile("config.json", "{}"),
+				),
+			},
+			{
+				Config: envGroupWithChildrenConfig("postgres://two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("render_env_group.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("render_env_group_env_var.test", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("render_env_group_secret_file.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_env_group.test", "env_vars.%", "1"),
+					env.CheckEnvVar("DATABASE_URL", "postgres://two"),
+					env.CheckEnvVar("API_KEY", "secret"),
+					env.CheckSecretFile("config.json", "{}"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/envgroup"
+	"terraform-provider-render/internal/provider/types/listresource"
+)
+
+var _ list.ListResourceWithConfigure = &envGroupResource{}
+
+// NewEnvGroupListResource is a helper function to simplify the provider implementation.
+func NewEnvGroupListResource() list.ListResource {
+	return &envGroupResource{}
+}
+
+func (r *envGroupResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listresource.Schema("Lists the environment groups in a Render workspace.", true)
+}
+
+// List streams the environment groups owned by the workspace.
+func (r *envGroupResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ownerID, diags := common.ListOwnerID(ctx, req.Config, r.ownerID)
+	environmentIDs, envDiags := common.ListEnvironmentIDs(ctx, req.Config)
+	diags.Append(envDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := envgroup.List(ctx, r.client, ownerID, common.ListFilter{EnvironmentIDs: environmentIDs}, req.Limit)
+	if err != nil {
+		diags.AddError("Unable to list environment groups", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := common.ListResults(ctx, req, r, ownerID, items, func(eg client.EnvGroupMeta) (string, string) {
+		return eg.Id, eg.Name
+	})
+	if !envgroup.MayBeTruncated(items, req.Limit) {
+		stream.Results = results
+		return
+	}
+
+	// The warning goes on the first result, as the stream has no diagnostics of
+	// its own.
+	stream.Results = func(push func(list.ListResult) bool) {
+		first := true
+		for result := range results {
+			if first {
+				envgroup.AddTruncatedWarning(&result.Diagnostics)
+				first = false
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-render/internal/client"
 	"terraform-provider-render/internal/provider/common"
 	"terraform-provider-render/internal/provider/envgroup"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &envGroupResource{}
 	_ resource.ResourceWithConfigure   = &envGroupResource{}
 	_ resource.ResourceWithImportState = &envGroupResource{}
+	_ resource.ResourceWithIdentity    = &envGroupResource{}
 )
 
 // NewenvGroupResource is a helper function to simplify the provider implementation.
@@ -53,6 +54,11 @@ func (r *envGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 	resp.Schema = EnvGroupResourceSchema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *envGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *envGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan envgroup.EnvGroupModel
@@ -62,6 +68,14 @@ func (r *envGroupResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	envVars, err := common.EnvVarsToClient(plan.EnvVars)
 	if err != nil {
 		resp.Diagnostics.AddError("invalid env vars", err.Error())
@@ -86,8 +100,11 @@ func (r *envGroupResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, envgroup.ModelFromClient(&envGroup, plan.EnvVars))
+	model := envgroup.ModelFromClient(&envGroup, plan.EnvVars, plan.SecretFiles)
+	model.IgnoreUnmanagedEnvVars = plan.IgnoreUnmanagedEnvVars
+	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 // Read resource information.
@@ -124,9 +141,17 @@ func (r *envGroupResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	refreshed := &envGroup
+	if state.IgnoreUnmanagedEnvVars.ValueBool() {
+		refreshed = envgroup.ListedOnly(refreshed, evs, state.SecretFiles)
+	}
+
 	// Set refreshed state
-	diags = resp.State.Set(ctx, envgroup.ModelFromClient(&envGroup, evs))
+	model := envgroup.ModelFromClient(refreshed, evs, state.SecretFiles)
+	model.IgnoreUnmanagedEnvVars = state.IgnoreUnmanagedEnvVars
+	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *envGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -144,12 +169,25 @@ func (r *envGroupResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	envGroupID := state.Id.ValueString()
 
+	stateEnvVars, stateSecretFiles := state.EnvVars, state.SecretFiles
+	if listed := common.NewListedEnv(state.IgnoreUnmanagedEnvVars, plan.IgnoreUnmanagedEnvVars, state.EnvVars, plan.EnvVars, state.SecretFiles, plan.SecretFiles); listed != nil {
+		stateEnvVars, stateSecretFiles = listed.StateEnvVars, listed.StateSecretFiles
+	}
+
 	for k, v := range plan.EnvVars {
-		existingVal, exists := state.EnvVars[k]
-		if !exists || (!v.GenerateValue.ValueBool() && existingVal.Value != v.Value) {
-			err := r.updateEnvVar(ctx, envGroupID, k, v)
+		existingVal, exists := stateEnvVars[k]
+		if !exists || common.EnvVarChanged(existingVal, v) {
+			_, err := envgroup.UpsertEnvVar(ctx, r.client, envGroupID, k, v)
 			if err != nil {
 				resp.Diagnostics.AddError("unable to create or update env var: "+k, err.Error())
 				return
@@ -157,9 +195,9 @@ func (r *envGroupResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	for k := range state.EnvVars {
+	for k := range stateEnvVars {
 		if _, ok := plan.EnvVars[k]; !ok {
-			err := r.deleteEnvVar(ctx, envGroupID, k)
+			err := envgroup.DeleteEnvVar(ctx, r.client, envGroupID, k)
 			if err != nil {
 				resp.Diagnostics.AddError("unable to remove env var: "+k, err.Error())
 				return
@@ -168,9 +206,9 @@ func (r *envGroupResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	for k, v := range plan.SecretFiles {
-		existingVal, exists := state.SecretFiles[k]
-		if !exists || existingVal.Content != v.Content {
-			err := r.updateSecretFile(ctx, envGroupID, k, v.Content)
+		existingVal, exists := stateSecretFiles[k]
+		if !exists || common.SecretFileChanged(existingVal, v) {
+			_, err := envgroup.UpsertSecretFile(ctx, r.client, envGroupID, k, v.ContentValue())
 			if err != nil {
 				resp.Diagnostics.AddError("unable to create or update secret file: "+k, err.Error())
 				return
@@ -178,9 +216,9 @@ func (r *envGroupResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	for k := range state.SecretFiles {
+	for k := range stateSecretFiles {
 		if _, ok := plan.SecretFiles[k]; !ok {
-			err := r.deleteSecretFile(ctx, envGroupID, k)
+			err := envgroup.DeleteSecretFile(ctx, r.client, envGroupID, k)
 			if err != nil {
 				resp.Diagnostics.AddError("unable to remove secret file: "+k, err.Error())
 				return
@@ -214,64 +252,16 @@ func (r *envGroupResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
-	// Set state to fully populated data
-	diags = resp.State.Set(ctx, envgroup.ModelFromClient(envGroup, nil))
-	resp.Diagnostics.Append(diags...)
-}
-
-func (r *envGroupResource) updateEnvVar(ctx context.Context, envGroupID, key string, value common.EnvVarModel) error {
-	body, err := common.EnvVarAddUpdateToClient(key, value)
-	if err != nil {
-		return err
-	}
-
-	resp, err := r.client.UpdateEnvGroupEnvVar(ctx, envGroupID, key, *body)
-	if err != nil {
-		return err
+	if plan.IgnoreUnmanagedEnvVars.ValueBool() {
+		envGroup = envgroup.ListedOnly(envGroup, plan.EnvVars, plan.SecretFiles)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-	return nil
-}
-
-func (r *envGroupResource) deleteEnvVar(ctx context.Context, envGroupID string, key string) error {
-	resp, err := r.client.DeleteEnvGroupEnvVar(ctx, envGroupID, key)
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-	return nil
-}
-
-func (r *envGroupResource) updateSecretFile(ctx context.Context, envGroupID, key, content string) error {
-	resp, err := r.client.UpdateEnvGroupSecretFile(ctx, envGroupID, key, client.UpdateEnvGroupSecretFileJSONRequestBody{
-		Content: &content,
-	})
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-	return nil
-}
-
-func (r *envGroupResource) deleteSecretFile(ctx context.Context, envGroupID string, key string) error {
-	resp, err := r.client.DeleteEnvGroupSecretFile(ctx, envGroupID, key)
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-	return nil
+	// Set state to fully populated data
+	model := envgroup.ModelFromClient(envGroup, plan.EnvVars, plan.SecretFiles)
+	model.IgnoreUnmanagedEnvVars = plan.IgnoreUnmanagedEnvVars
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *envGroupResource) updateEnvGroupName(ctx context.Context, envGroupID, name string) (*client.EnvGroup, error) {
@@ -309,6 +299,13 @@ func (r *envGroupResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *envGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is either the resource ID or a name reference such as
+	// name:my-api or env:<environment_id>/name:my-api.
+	common.ImportStateByName(ctx, req, resp, func(ref common.NameRef) (string, error) {
+		found, err := envgroup.FindByName(ctx, r.client, r.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
 }
@@ -2,11 +2,14 @@ package resource
 
 import (
 	"context"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-render/internal/provider/common/validators"
@@ -20,6 +23,9 @@ func EnvGroupResourceSchema(ctx context.Context) schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Unique identifier for this environment group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -29,6 +35,11 @@ func EnvGroupResourceSchema(ctx context.Context) schema.Schema {
 			"environment_id": resource.ResourceEnvironmentID,
 			"env_vars":       resource.EnvVars,
 			"secret_files":   resource.SecretFiles,
+			"ignore_unmanaged_env_vars": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "When true, only the env vars and secret files listed in this resource are managed. Keys set elsewhere, for example with render_env_group_env_var or render_env_group_secret_file, are left alone instead of being removed. Defaults to false.",
+				MarkdownDescription: "When `true`, only the env vars and secret files listed in this resource are managed. Keys set elsewhere, for example with `render_env_group_env_var` or `render_env_group_secret_file`, are left alone instead of being removed. Defaults to `false`.",
+			},
 		},
 	}
 }
@@ -53,3 +64,70 @@ func EnvGroupLinkResourceSchema(ctx context.Context) schema.Schema {
 		},
 	}
 }
+
+func EnvGroupEnvVarResourceSchema(_ context.Context) schema.Schema {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for this env var, of the form <env_group_id>/<key>.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"env_group_id": envGroupChildID("ID of the environment group to set the env var on."),
+		"key": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the env var.",
+			Validators:  []validator.String{validators.StringNotEmpty},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+	maps.Copy(attributes, resource.EnvVar.Attributes)
+
+	return schema.Schema{
+		Description:         "Provides a single environment variable of a Render Environment Group. Other keys of the group are left alone, so several modules or workspaces can add keys to a shared group. Set ignore_unmanaged_env_vars on the render_env_group resource so that it does not remove the key.",
+		MarkdownDescription: "Provides a single environment variable of a Render Environment Group. Other keys of the group are left alone, so several modules or workspaces can add keys to a shared group. Set `ignore_unmanaged_env_vars` on the `render_env_group` resource so that it does not remove the key.",
+		Attributes:          attributes,
+	}
+}
+
+func EnvGroupSecretFileResourceSchema(_ context.Context) schema.Schema {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for this secret file, of the form <env_group_id>/<name>.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"env_group_id": envGroupChildID("ID of the environment group to add the secret file to."),
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Path of the secret file.",
+			Validators:  []validator.String{validators.StringNotEmpty},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+	maps.Copy(attributes, resource.SecretFile.Attributes)
+
+	return schema.Schema{
+		Description:         "Provides a single secret file of a Render Environment Group. Other keys of the group are left alone, so several modules or workspaces can add files to a shared group. Set ignore_unmanaged_env_vars on the render_env_group resource so that it does not remove the file.",
+		MarkdownDescription: "Provides a single secret file of a Render Environment Group. Other keys of the group are left alone, so several modules or workspaces can add files to a shared group. Set `ignore_unmanaged_env_vars` on the `render_env_group` resource so that it does not remove the file.",
+		Attributes:          attributes,
+	}
+}
+
+func envGroupChildID(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:    true,
+		Description: description,
+		Validators:  []validator.String{validators.StringNotEmpty},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
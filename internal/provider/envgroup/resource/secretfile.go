@@ -0,0 +1,148 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/envgroup"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+var (
+	_ resource.Resource                = &envGroupSecretFileResource{}
+	_ resource.ResourceWithConfigure   = &envGroupSecretFileResource{}
+	_ resource.ResourceWithImportState = &envGroupSecretFileResource{}
+)
+
+func NewEnvGroupSecretFileResource() resource.Resource {
+	return &envGroupSecretFileResource{}
+}
+
+type envGroupSecretFileResource struct {
+	client *client.ClientWithResponses
+}
+
+func (r *envGroupSecretFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := rendertypes.ConfigureResource(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *envGroupSecretFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_group_secret_file"
+}
+
+func (r *envGroupSecretFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = EnvGroupSecretFileResourceSchema(ctx)
+}
+
+func (r *envGroupSecretFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan envgroup.SecretFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.upsert(ctx, req.Config, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating secret file", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *envGroupSecretFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state envgroup.SecretFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sf, err := envgroup.GetSecretFile(ctx, r.client, state.EnvGroupID.ValueString(), state.Name.ValueString())
+	if common.IsNotFoundErr(err) {
+		common.EmitNotFoundWarning(state.ID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading secret file", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, envgroup.SecretFileModelFromClient(state.EnvGroupID.ValueString(), *sf, state))...)
+}
+
+func (r *envGroupSecretFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan envgroup.SecretFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.upsert(ctx, req.Config, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating secret file", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *envGroupSecretFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state envgroup.SecretFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := envgroup.DeleteSecretFile(ctx, r.client, state.EnvGroupID.ValueString(), state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting secret file", err.Error())
+		return
+	}
+}
+
+// ImportState imports an secret file from an ID of the form <env_group_id>/<name>.
+func (r *envGroupSecretFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	envGroupID, name, err := parseChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("env_group_id"), envGroupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// upsert writes the planned content, reading content_wo from the config since it
+// is never part of the plan.
+func (r *envGroupSecretFileResource) upsert(ctx context.Context, config tfsdk.Config, plan envgroup.SecretFileModel, diags *diag.Diagnostics) (envgroup.SecretFileModel, error) {
+	contentWO, d := common.WriteOnlyString(ctx, config, path.Root("content_wo"))
+	diags.Append(d...)
+	if diags.HasError() {
+		return plan, nil
+	}
+	plan.ContentWO = contentWO
+
+	sf, err := envgroup.UpsertSecretFile(ctx, r.client, plan.EnvGroupID.ValueString(), plan.Name.ValueString(), plan.SecretFile().ContentValue())
+	if err != nil {
+		return plan, err
+	}
+
+	return envgroup.SecretFileModelFromClient(plan.EnvGroupID.ValueString(), *sf, plan), nil
+}
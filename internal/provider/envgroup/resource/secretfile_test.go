@@ -0,0 +1,71 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-render/internal/provider"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func envGroupSecretFileConfig(content string) string {
+	return providerCfg + fmt.Sprintf(`
+resource "render_env_group_secret_file" "test" {
+  env_group_id = "evg-1"
+  name         = "config.json"
+  content      = %q
+}
+`, content)
+}
+
+func TestEnvGroupSecretFileResource(t *testing.T) {
+	env := th.NewFakeEnv()
+	env.SetSecretFile("other.txt", "kept")
+	fakeServer := envGroupServer(t, env)
+	resourceName := "render_env_group_secret_file.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(fakeServer.URL))()),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			env.CheckNoSecretFile("config.json"),
+			env.CheckSecretFile("other.txt", "kept"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: envGroupSecretFileConfig(`{"debug":false}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "evg-1/config.json"),
+					resource.TestCheckResourceAttr(resourceName, "env_group_id", "evg-1"),
+					resource.TestCheckResourceAttr(resourceName, "name", "config.json"),
+					resource.TestCheckResourceAttr(resourceName, "content", `{"debug":false}`),
+					env.CheckSecretFile("config.json", `{"debug":false}`),
+				),
+			},
+			{
+				// Changed outside of Terraform, so that the refresh has to read
+				// the file again.
+				PreConfig: func() { env.SetSecretFile("config.json", `{"debug":true}`) },
+				Config:    envGroupSecretFileConfig(`{"debug":false}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: env.CheckSecretFile("config.json", `{"debug":false}`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "evg-1/config.json",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
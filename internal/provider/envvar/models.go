@@ -0,0 +1,50 @@
+package envvar
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// Model is a single env var of a service, managed separately from the
+// service's env_vars.
+type Model struct {
+	ID             types.String `tfsdk:"id"`
+	ServiceID      types.String `tfsdk:"service_id"`
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	GenerateValue  types.Bool   `tfsdk:"generate_value"`
+}
+
+// EnvVar returns the value of the env var in the form shared with env_vars.
+func (m Model) EnvVar() common.EnvVarModel {
+	return common.EnvVarModel{
+		Value:          m.Value,
+		ValueWO:        m.ValueWO,
+		ValueWOVersion: m.ValueWOVersion,
+		GenerateValue:  m.GenerateValue,
+	}
+}
+
+// ModelFromClient builds the state of an env var. generate_value and the
+// write-only version are not returned by the API, so they are kept from prior.
+func ModelFromClient(serviceID string, ev client.EnvVar, prior Model) Model {
+	m := Model{
+		ID:             types.StringValue(serviceID + "/" + ev.Key),
+		ServiceID:      types.StringValue(serviceID),
+		Key:            types.StringValue(ev.Key),
+		Value:          types.StringValue(ev.Value),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: prior.ValueWOVersion,
+		GenerateValue:  types.BoolValue(prior.GenerateValue.ValueBool()),
+	}
+
+	if prior.EnvVar().IsWriteOnly() {
+		m.Value = types.StringNull()
+	}
+
+	return m
+}
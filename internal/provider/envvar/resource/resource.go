@@ -0,0 +1,148 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/envvar"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+var (
+	_ resource.Resource                = &envVarResource{}
+	_ resource.ResourceWithConfigure   = &envVarResource{}
+	_ resource.ResourceWithImportState = &envVarResource{}
+)
+
+func NewEnvVarResource() resource.Resource {
+	return &envVarResource{}
+}
+
+type envVarResource struct {
+	client *client.ClientWithResponses
+}
+
+func (r *envVarResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := rendertypes.ConfigureResource(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *envVarResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_var"
+}
+
+func (r *envVarResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+func (r *envVarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan envvar.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.upsert(ctx, req.Config, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating env var", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *envVarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state envvar.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ev, err := common.GetServiceEnvVar(ctx, r.client, state.ServiceID.ValueString(), state.Key.ValueString())
+	if common.IsNotFoundErr(err) {
+		common.EmitNotFoundWarning(state.ID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading env var", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, envvar.ModelFromClient(state.ServiceID.ValueString(), *ev, state))...)
+}
+
+func (r *envVarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan envvar.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.upsert(ctx, req.Config, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating env var", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *envVarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state envvar.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := common.DeleteServiceEnvVar(ctx, r.client, state.ServiceID.ValueString(), state.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting env var", err.Error())
+		return
+	}
+}
+
+// ImportState imports an env var from an ID of the form <service_id>/<key>.
+func (r *envVarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, key, err := common.ParseServiceChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// upsert writes the planned value, reading value_wo from the config since it
+// is never part of the plan.
+func (r *envVarResource) upsert(ctx context.Context, config tfsdk.Config, plan envvar.Model, diags *diag.Diagnostics) (envvar.Model, error) {
+	valueWO, d := common.WriteOnlyString(ctx, config, path.Root("value_wo"))
+	diags.Append(d...)
+	if diags.HasError() {
+		return plan, nil
+	}
+	plan.ValueWO = valueWO
+
+	ev, err := common.UpsertServiceEnvVar(ctx, r.client, plan.ServiceID.ValueString(), plan.Key.ValueString(), plan.EnvVar())
+	if err != nil {
+		return plan, err
+	}
+
+	return envvar.ModelFromClient(plan.ServiceID.ValueString(), *ev, plan), nil
+}
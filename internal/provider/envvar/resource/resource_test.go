@@ -0,0 +1,174 @@
+package resource_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+const providerCfg = `
+provider "render" {
+  api_key = "some-api-key"
+  owner_id = "some-owner-id"
+}
+`
+
+const staticSite = `{"autoDeploy":"no","autoDeployTrigger":"off","branch":"main","createdAt":"2025-05-08T20:58:07.724167Z","dashboardUrl":"https://dashboard.render.com/static/srv-1","id":"srv-1","name":"docs","notifyOnFail":"default","ownerId":"some-owner-id","repo":"https://github.com/render-examples/sveltekit-static","rootDir":"","serviceDetails":{"buildCommand":"npm run build","buildPlan":"starter","previews":{"generation":"off"},"publishPath":"public","pullRequestPreviewsEnabled":"no","url":"https://docs.onrender.com"},"slug":"docs","suspended":"not_suspended","suspenders":[],"type":"static_site","updatedAt":"2025-05-08T20:58:09.049016Z"}`
+
+func providerFactories(url string) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(url))()),
+	}
+}
+
+func envVarConfig(value string) string {
+	return providerCfg + fmt.Sprintf(`
+resource "render_env_var" "test" {
+  service_id = "srv-1"
+  key        = "LOG_LEVEL"
+  value      = %q
+}
+`, value)
+}
+
+func TestEnvVarResource(t *testing.T) {
+	env := th.NewFakeEnv()
+	env.SetEnvVar("OTHER", "kept")
+	fakeServer := th.NewMockRenderAPI(env.Handlers("/services/srv-1"))
+	resourceName := "render_env_var.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(fakeServer.URL),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			env.CheckNoEnvVar("LOG_LEVEL"),
+			env.CheckEnvVar("OTHER", "kept"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: envVarConfig("info"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "srv-1/LOG_LEVEL"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "srv-1"),
+					resource.TestCheckResourceAttr(resourceName, "key", "LOG_LEVEL"),
+					resource.TestCheckResourceAttr(resourceName, "value", "info"),
+					resource.TestCheckResourceAttr(resourceName, "generate_value", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "srv-1/LOG_LEVEL",
+				ImportStateVerify: true,
+			},
+			{
+				Config: envVarConfig("debug"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "debug"),
+					env.CheckEnvVar("LOG_LEVEL", "debug"),
+					env.CheckEnvVar("OTHER", "kept"),
+				),
+			},
+		},
+	})
+}
+
+func staticSiteConfig(buildEnv string) string {
+	return providerCfg + fmt.Sprintf(`
+resource "render_static_site" "test" {
+  name          = "docs"
+  repo_url      = "https://github.com/render-examples/sveltekit-static"
+  branch        = "main"
+  build_command = "npm run build"
+  auto_deploy   = false
+
+  ignore_unmanaged_env_vars = true
+  env_vars = {
+    BUILD_ENV = { value = %q }
+  }
+}
+
+resource "render_env_var" "test" {
+  service_id = render_static_site.test.id
+  key        = "API_KEY"
+  value      = "secret"
+}
+`, buildEnv)
+}
+
+func TestEnvVarResourceWithUnmanagedEnvVarsIgnored(t *testing.T) {
+	env := th.NewFakeEnv()
+	handlers := env.Handlers("/services/srv-1")
+	maps.Copy(handlers, map[string]http.HandlerFunc{
+		"/services": func(resp http.ResponseWriter, req *http.Request) {
+			var body struct {
+				EnvVars []client.EnvVarKeyValue `json:"envVars"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			for _, ev := range body.EnvVars {
+				env.SetEnvVar(ev.Key, ev.Value)
+			}
+
+			resp.WriteHeader(http.StatusCreated)
+			th.StaticResponse(`{"deployId":"dep-1","service":`+staticSite+`}`)(resp, req)
+		},
+		"/services/srv-1": func(resp http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodDelete {
+				resp.WriteHeader(http.StatusNoContent)
+				return
+			}
+			th.StaticResponse(staticSite)(resp, req)
+		},
+		"/services/srv-1/deploys":        th.StaticResponse(`{"id":"dep-2"}`),
+		"/services/srv-1/custom-domains": th.StaticResponse([]struct{}{}),
+		"/services/srv-1/headers":        th.StaticResponse([]struct{}{}),
+		"/services/srv-1/routes":         th.StaticResponse([]struct{}{}),
+		"/notification-settings/overrides/services/srv-1": th.StaticResponse(
+			`{"serviceId":"srv-1","notificationsToSend":"default","previewNotificationsEnabled":"default"}`,
+		),
+	})
+	fakeServer := th.NewMockRenderAPI(handlers)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(fakeServer.URL),
+		Steps: []resource.TestStep{
+			{
+				Config: staticSiteConfig("production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_static_site.test", "env_vars.%", "1"),
+					env.CheckEnvVar("API_KEY", "secret"),
+				),
+			},
+			{
+				Config: staticSiteConfig("staging"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("render_static_site.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("render_env_var.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_static_site.test", "env_vars.%", "1"),
+					resource.TestCheckResourceAttr("render_static_site.test", "env_vars.BUILD_ENV.value", "staging"),
+					env.CheckEnvVar("API_KEY", "secret"),
+				),
+			},
+		},
+	})
+}
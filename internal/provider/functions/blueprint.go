@@ -0,0 +1,698 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Blueprint holds the objects of a render.yaml document keyed by name. The
+// attribute names match the schemas of the corresponding resources.
+type Blueprint struct {
+	WebServices       map[string]WebService       `tfsdk:"web_services"`
+	PrivateServices   map[string]PrivateService   `tfsdk:"private_services"`
+	BackgroundWorkers map[string]BackgroundWorker `tfsdk:"background_workers"`
+	CronJobs          map[string]CronJob          `tfsdk:"cron_jobs"`
+	StaticSites       map[string]StaticSite       `tfsdk:"static_sites"`
+	Postgres          map[string]Postgres         `tfsdk:"postgres"`
+	KeyValue          map[string]KeyValue         `tfsdk:"keyvalue"`
+	EnvGroups         map[string]EnvGroup         `tfsdk:"env_groups"`
+}
+
+type WebService struct {
+	Name                       string            `tfsdk:"name"`
+	Plan                       *string           `tfsdk:"plan"`
+	Region                     *string           `tfsdk:"region"`
+	RuntimeSource              RuntimeSource     `tfsdk:"runtime_source"`
+	RootDirectory              *string           `tfsdk:"root_directory"`
+	StartCommand               *string           `tfsdk:"start_command"`
+	PreDeployCommand           *string           `tfsdk:"pre_deploy_command"`
+	HealthCheckPath            *string           `tfsdk:"health_check_path"`
+	NumInstances               *int64            `tfsdk:"num_instances"`
+	Autoscaling                *Autoscaling      `tfsdk:"autoscaling"`
+	Disk                       *Disk             `tfsdk:"disk"`
+	CustomDomains              []CustomDomain    `tfsdk:"custom_domains"`
+	Previews                   *Previews         `tfsdk:"previews"`
+	PullRequestPreviewsEnabled *bool             `tfsdk:"pull_request_previews_enabled"`
+	MaxShutdownDelaySeconds    *int64            `tfsdk:"max_shutdown_delay_seconds"`
+	EnvVars                    map[string]EnvVar `tfsdk:"env_vars"`
+}
+
+type PrivateService struct {
+	Name                       string            `tfsdk:"name"`
+	Plan                       *string           `tfsdk:"plan"`
+	Region                     *string           `tfsdk:"region"`
+	RuntimeSource              RuntimeSource     `tfsdk:"runtime_source"`
+	RootDirectory              *string           `tfsdk:"root_directory"`
+	StartCommand               *string           `tfsdk:"start_command"`
+	PreDeployCommand           *string           `tfsdk:"pre_deploy_command"`
+	NumInstances               *int64            `tfsdk:"num_instances"`
+	Autoscaling                *Autoscaling      `tfsdk:"autoscaling"`
+	Disk                       *Disk             `tfsdk:"disk"`
+	Previews                   *Previews         `tfsdk:"previews"`
+	PullRequestPreviewsEnabled *bool             `tfsdk:"pull_request_previews_enabled"`
+	MaxShutdownDelaySeconds    *int64            `tfsdk:"max_shutdown_delay_seconds"`
+	EnvVars                    map[string]EnvVar `tfsdk:"env_vars"`
+}
+
+// BackgroundWorker has the same attributes as PrivateService.
+type BackgroundWorker PrivateService
+
+type CronJob struct {
+	Name          string            `tfsdk:"name"`
+	Plan          *string           `tfsdk:"plan"`
+	Region        *string           `tfsdk:"region"`
+	RuntimeSource RuntimeSource     `tfsdk:"runtime_source"`
+	RootDirectory *string           `tfsdk:"root_directory"`
+	Schedule      string            `tfsdk:"schedule"`
+	StartCommand  *string           `tfsdk:"start_command"`
+	EnvVars       map[string]EnvVar `tfsdk:"env_vars"`
+}
+
+type StaticSite struct {
+	Name                       string            `tfsdk:"name"`
+	RepoURL                    *string           `tfsdk:"repo_url"`
+	Branch                     *string           `tfsdk:"branch"`
+	AutoDeploy                 *bool             `tfsdk:"auto_deploy"`
+	AutoDeployTrigger          *string           `tfsdk:"auto_deploy_trigger"`
+	BuildCommand               *string           `tfsdk:"build_command"`
+	BuildFilter                *BuildFilter      `tfsdk:"build_filter"`
+	PublishPath                *string           `tfsdk:"publish_path"`
+	RootDirectory              *string           `tfsdk:"root_directory"`
+	Headers                    []Header          `tfsdk:"headers"`
+	Routes                     []Route           `tfsdk:"routes"`
+	CustomDomains              []CustomDomain    `tfsdk:"custom_domains"`
+	Previews                   *Previews         `tfsdk:"previews"`
+	PullRequestPreviewsEnabled *bool             `tfsdk:"pull_request_previews_enabled"`
+	EnvVars                    map[string]EnvVar `tfsdk:"env_vars"`
+}
+
+type Postgres struct {
+	Name                    string             `tfsdk:"name"`
+	Plan                    *string            `tfsdk:"plan"`
+	Region                  *string            `tfsdk:"region"`
+	DatabaseName            *string            `tfsdk:"database_name"`
+	DatabaseUser            *string            `tfsdk:"database_user"`
+	Version                 *string            `tfsdk:"version"`
+	HighAvailabilityEnabled *bool              `tfsdk:"high_availability_enabled"`
+	DiskSizeGB              *int64             `tfsdk:"disk_size_gb"`
+	IPAllowList             []IPAllowListEntry `tfsdk:"ip_allow_list"`
+	ReadReplicas            []ReadReplica      `tfsdk:"read_replicas"`
+}
+
+type KeyValue struct {
+	Name            string             `tfsdk:"name"`
+	Plan            *string            `tfsdk:"plan"`
+	Region          *string            `tfsdk:"region"`
+	MaxMemoryPolicy *string            `tfsdk:"max_memory_policy"`
+	IPAllowList     []IPAllowListEntry `tfsdk:"ip_allow_list"`
+}
+
+type EnvGroup struct {
+	Name    string            `tfsdk:"name"`
+	EnvVars map[string]EnvVar `tfsdk:"env_vars"`
+}
+
+type EnvVar struct {
+	Value         *string `tfsdk:"value"`
+	GenerateValue *bool   `tfsdk:"generate_value"`
+}
+
+// RuntimeSource has exactly one of its attributes set.
+type RuntimeSource struct {
+	NativeRuntime *NativeRuntime `tfsdk:"native_runtime"`
+	Docker        *Docker        `tfsdk:"docker"`
+	Image         *Image         `tfsdk:"image"`
+}
+
+type NativeRuntime struct {
+	Runtime           string       `tfsdk:"runtime"`
+	RepoURL           *string      `tfsdk:"repo_url"`
+	Branch            *string      `tfsdk:"branch"`
+	BuildCommand      *string      `tfsdk:"build_command"`
+	BuildFilter       *BuildFilter `tfsdk:"build_filter"`
+	AutoDeploy        *bool        `tfsdk:"auto_deploy"`
+	AutoDeployTrigger *string      `tfsdk:"auto_deploy_trigger"`
+}
+
+type Docker struct {
+	RepoURL           *string      `tfsdk:"repo_url"`
+	Branch            *string      `tfsdk:"branch"`
+	Context           *string      `tfsdk:"context"`
+	DockerfilePath    *string      `tfsdk:"dockerfile_path"`
+	BuildFilter       *BuildFilter `tfsdk:"build_filter"`
+	AutoDeploy        *bool        `tfsdk:"auto_deploy"`
+	AutoDeployTrigger *string      `tfsdk:"auto_deploy_trigger"`
+}
+
+type Image struct {
+	ImageURL string  `tfsdk:"image_url"`
+	Tag      *string `tfsdk:"tag"`
+	Digest   *string `tfsdk:"digest"`
+}
+
+type BuildFilter struct {
+	Paths        []string `tfsdk:"paths"`
+	IgnoredPaths []string `tfsdk:"ignored_paths"`
+}
+
+type Autoscaling struct {
+	Enabled  bool                `tfsdk:"enabled"`
+	Min      int64               `tfsdk:"min"`
+	Max      int64               `tfsdk:"max"`
+	Criteria AutoscalingCriteria `tfsdk:"criteria"`
+}
+
+type AutoscalingCriteria struct {
+	CPU    AutoscalingTarget `tfsdk:"cpu"`
+	Memory AutoscalingTarget `tfsdk:"memory"`
+}
+
+type AutoscalingTarget struct {
+	Enabled    bool  `tfsdk:"enabled"`
+	Percentage int64 `tfsdk:"percentage"`
+}
+
+type Disk struct {
+	Name      string `tfsdk:"name"`
+	MountPath string `tfsdk:"mount_path"`
+	SizeGB    int64  `tfsdk:"size_gb"`
+}
+
+type CustomDomain struct {
+	Name string `tfsdk:"name"`
+}
+
+type Previews struct {
+	Generation *string `tfsdk:"generation"`
+}
+
+type Header struct {
+	Path  string `tfsdk:"path"`
+	Name  string `tfsdk:"name"`
+	Value string `tfsdk:"value"`
+}
+
+type Route struct {
+	Type        string `tfsdk:"type"`
+	Source      string `tfsdk:"source"`
+	Destination string `tfsdk:"destination"`
+}
+
+type IPAllowListEntry struct {
+	CIDRBlock   string  `tfsdk:"cidr_block"`
+	Description *string `tfsdk:"description"`
+}
+
+type ReadReplica struct {
+	Name string `tfsdk:"name"`
+}
+
+// Blueprint defaults that differ from the API defaults.
+const (
+	defaultDiskSizeGB    = 10
+	defaultTargetPercent = 90
+)
+
+var nativeRuntimes = []string{"elixir", "go", "node", "python", "ruby", "rust"}
+
+// DecodeBlueprint decodes a render.yaml document. Keys that have no equivalent
+// in the provider, such as fromDatabase env vars, are reported with their path
+// instead of being dropped.
+func DecodeBlueprint(content string) (Blueprint, error) {
+	bp := Blueprint{
+		WebServices:       map[string]WebService{},
+		PrivateServices:   map[string]PrivateService{},
+		BackgroundWorkers: map[string]BackgroundWorker{},
+		CronJobs:          map[string]CronJob{},
+		StaticSites:       map[string]StaticSite{},
+		Postgres:          map[string]Postgres{},
+		KeyValue:          map[string]KeyValue{},
+		EnvGroups:         map[string]EnvGroup{},
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return bp, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return bp, nil
+	}
+
+	err := decodeMapping(doc.Content[0], "", func(m *yamlMapping) error {
+		// The version key carries no settings.
+		m.node("version")
+
+		m.sequence("services", func(n *yaml.Node, path string) error {
+			return decodeMapping(n, path, func(m *yamlMapping) error {
+				return bp.decodeService(m)
+			})
+		})
+		m.sequence("databases", func(n *yaml.Node, path string) error {
+			return decodeMapping(n, path, func(m *yamlMapping) error {
+				return bp.decodePostgres(m)
+			})
+		})
+		m.sequence("envVarGroups", func(n *yaml.Node, path string) error {
+			return decodeMapping(n, path, func(m *yamlMapping) error {
+				return bp.decodeEnvGroup(m)
+			})
+		})
+		return nil
+	})
+	return bp, err
+}
+
+func (bp *Blueprint) decodeService(m *yamlMapping) error {
+	typ := m.requiredString("type")
+	name := m.requiredString("name")
+	if m.err != nil {
+		return m.err
+	}
+
+	// env is the former name of runtime.
+	runtime := m.string("runtime")
+	if runtime == nil {
+		runtime = m.string("env")
+	}
+
+	if typ == "keyvalue" || typ == "redis" {
+		if runtime != nil {
+			return errorAt(m.at("runtime"), m.self, "unsupported key")
+		}
+		return addNamed(bp.KeyValue, m, name, decodeKeyValue(m, name))
+	}
+
+	if runtime == nil {
+		return errorAt(m.at("runtime"), m.self, "required key is missing")
+	}
+
+	if typ == "web" && *runtime == "static" {
+		return addNamed(bp.StaticSites, m, name, decodeStaticSite(m, name))
+	}
+
+	switch typ {
+	case "web":
+		svc := WebService{
+			Name:                       name,
+			Plan:                       m.string("plan"),
+			Region:                     m.string("region"),
+			RuntimeSource:              decodeRuntimeSource(m, *runtime),
+			RootDirectory:              m.string("rootDir"),
+			StartCommand:               decodeStartCommand(m, *runtime),
+			PreDeployCommand:           m.string("preDeployCommand"),
+			HealthCheckPath:            m.string("healthCheckPath"),
+			NumInstances:               m.int64("numInstances"),
+			Autoscaling:                decodeAutoscaling(m),
+			Disk:                       decodeDisk(m),
+			CustomDomains:              decodeCustomDomains(m),
+			Previews:                   decodePreviews(m),
+			PullRequestPreviewsEnabled: m.bool("pullRequestPreviewsEnabled"),
+			MaxShutdownDelaySeconds:    m.int64("maxShutdownDelaySeconds"),
+			EnvVars:                    decodeEnvVars(m),
+		}
+		return addNamed(bp.WebServices, m, name, svc)
+	case "pserv", "worker":
+		svc := PrivateService{
+			Name:                       name,
+			Plan:                       m.string("plan"),
+			Region:                     m.string("region"),
+			RuntimeSource:              decodeRuntimeSource(m, *runtime),
+			RootDirectory:              m.string("rootDir"),
+			StartCommand:               decodeStartCommand(m, *runtime),
+			PreDeployCommand:           m.string("preDeployCommand"),
+			NumInstances:               m.int64("numInstances"),
+			Autoscaling:                decodeAutoscaling(m),
+			Disk:                       decodeDisk(m),
+			Previews:                   decodePreviews(m),
+			PullRequestPreviewsEnabled: m.bool("pullRequestPreviewsEnabled"),
+			MaxShutdownDelaySeconds:    m.int64("maxShutdownDelaySeconds"),
+			EnvVars:                    decodeEnvVars(m),
+		}
+		if typ == "worker" {
+			return addNamed(bp.BackgroundWorkers, m, name, BackgroundWorker(svc))
+		}
+		return addNamed(bp.PrivateServices, m, name, svc)
+	case "cron":
+		job := CronJob{
+			Name:          name,
+			Plan:          m.string("plan"),
+			Region:        m.string("region"),
+			RuntimeSource: decodeRuntimeSource(m, *runtime),
+			RootDirectory: m.string("rootDir"),
+			Schedule:      m.requiredString("schedule"),
+			StartCommand:  decodeStartCommand(m, *runtime),
+			EnvVars:       decodeEnvVars(m),
+		}
+		return addNamed(bp.CronJobs, m, name, job)
+	}
+
+	return errorAt(m.at("type"), m.values["type"], "unsupported service type %q", typ)
+}
+
+func decodeStaticSite(m *yamlMapping, name string) StaticSite {
+	site := StaticSite{
+		Name:                       name,
+		RepoURL:                    m.string("repo"),
+		Branch:                     m.string("branch"),
+		AutoDeploy:                 m.bool("autoDeploy"),
+		AutoDeployTrigger:          m.string("autoDeployTrigger"),
+		BuildCommand:               m.string("buildCommand"),
+		BuildFilter:                decodeBuildFilter(m),
+		PublishPath:                m.string("staticPublishPath"),
+		RootDirectory:              m.string("rootDir"),
+		CustomDomains:              decodeCustomDomains(m),
+		Previews:                   decodePreviews(m),
+		PullRequestPreviewsEnabled: m.bool("pullRequestPreviewsEnabled"),
+		EnvVars:                    decodeEnvVars(m),
+	}
+
+	m.sequence("headers", func(n *yaml.Node, path string) error {
+		return decodeMapping(n, path, func(h *yamlMapping) error {
+			site.Headers = append(site.Headers, Header{
+				Path:  h.requiredString("path"),
+				Name:  h.requiredString("name"),
+				Value: h.requiredString("value"),
+			})
+			return nil
+		})
+	})
+	m.sequence("routes", func(n *yaml.Node, path string) error {
+		return decodeMapping(n, path, func(r *yamlMapping) error {
+			site.Routes = append(site.Routes, Route{
+				Type:        r.requiredString("type"),
+				Source:      r.requiredString("source"),
+				Destination: r.requiredString("destination"),
+			})
+			return nil
+		})
+	})
+
+	return site
+}
+
+func decodeKeyValue(m *yamlMapping, name string) KeyValue {
+	return KeyValue{
+		Name:            name,
+		Plan:            m.string("plan"),
+		Region:          m.string("region"),
+		MaxMemoryPolicy: m.string("maxmemoryPolicy"),
+		IPAllowList:     decodeIPAllowList(m),
+	}
+}
+
+func (bp *Blueprint) decodePostgres(m *yamlMapping) error {
+	name := m.requiredString("name")
+	db := Postgres{
+		Name:         name,
+		Plan:         m.string("plan"),
+		Region:       m.string("region"),
+		DatabaseName: m.string("databaseName"),
+		DatabaseUser: m.string("user"),
+		Version:      m.string("postgresMajorVersion"),
+		DiskSizeGB:   m.int64("diskSizeGB"),
+		IPAllowList:  decodeIPAllowList(m),
+	}
+
+	m.mapping("highAvailability", func(ha *yamlMapping) error {
+		db.HighAvailabilityEnabled = ha.bool("enabled")
+		return nil
+	})
+	m.sequence("readReplicas", func(n *yaml.Node, path string) error {
+		return decodeMapping(n, path, func(r *yamlMapping) error {
+			db.ReadReplicas = append(db.ReadReplicas, ReadReplica{Name: r.requiredString("name")})
+			return nil
+		})
+	})
+
+	return addNamed(bp.Postgres, m, name, db)
+}
+
+func (bp *Blueprint) decodeEnvGroup(m *yamlMapping) error {
+	name := m.requiredString("name")
+	return addNamed(bp.EnvGroups, m, name, EnvGroup{Name: name, EnvVars: decodeEnvVars(m)})
+}
+
+// addNamed adds v to objects once every key of m was read without errors.
+func addNamed[T any](objects map[string]T, m *yamlMapping, name string, v T) error {
+	if err := m.done(); err != nil {
+		return err
+	}
+	if _, ok := objects[name]; ok {
+		return errorAt(m.at("name"), m.values["name"], "duplicate name %q", name)
+	}
+	objects[name] = v
+	return nil
+}
+
+func decodeRuntimeSource(m *yamlMapping, runtime string) RuntimeSource {
+	switch {
+	case runtime == "docker":
+		return RuntimeSource{Docker: &Docker{
+			RepoURL:           m.string("repo"),
+			Branch:            m.string("branch"),
+			Context:           m.string("dockerContext"),
+			DockerfilePath:    m.string("dockerfilePath"),
+			BuildFilter:       decodeBuildFilter(m),
+			AutoDeploy:        m.bool("autoDeploy"),
+			AutoDeployTrigger: m.string("autoDeployTrigger"),
+		}}
+	case runtime == "image":
+		var image Image
+		m.mapping("image", func(i *yamlMapping) error {
+			image = decodeImageURL(i.requiredString("url"))
+			return nil
+		})
+		if !m.has("image") && m.err == nil {
+			m.err = errorAt(m.at("image"), m.self, "required key is missing")
+		}
+		return RuntimeSource{Image: &image}
+	case slices.Contains(nativeRuntimes, runtime):
+		return RuntimeSource{NativeRuntime: &NativeRuntime{
+			Runtime:           runtime,
+			RepoURL:           m.string("repo"),
+			Branch:            m.string("branch"),
+			BuildCommand:      m.string("buildCommand"),
+			BuildFilter:       decodeBuildFilter(m),
+			AutoDeploy:        m.bool("autoDeploy"),
+			AutoDeployTrigger: m.string("autoDeployTrigger"),
+		}}
+	}
+
+	if m.err == nil {
+		m.err = errorAt(m.at("runtime"), m.self, "unsupported runtime %q", runtime)
+	}
+	return RuntimeSource{}
+}
+
+// decodeStartCommand reads the start command, which is called dockerCommand
+// for Docker and image-backed services.
+func decodeStartCommand(m *yamlMapping, runtime string) *string {
+	if runtime == "docker" || runtime == "image" {
+		return m.string("dockerCommand")
+	}
+	return m.string("startCommand")
+}
+
+// decodeImageURL splits an image reference such as
+// docker.io/library/nginx:1.27 into its URL and its tag or digest.
+func decodeImageURL(ref string) Image {
+	if url, digest, ok := strings.Cut(ref, "@"); ok {
+		return Image{ImageURL: url, Digest: &digest}
+	}
+
+	lastSlash := strings.LastIndex(ref, "/")
+	if i := strings.LastIndex(ref, ":"); i > lastSlash {
+		tag := ref[i+1:]
+		return Image{ImageURL: ref[:i], Tag: &tag}
+	}
+	return Image{ImageURL: ref}
+}
+
+func decodeBuildFilter(m *yamlMapping) *BuildFilter {
+	var filter *BuildFilter
+	m.mapping("buildFilter", func(f *yamlMapping) error {
+		filter = &BuildFilter{Paths: f.strings("paths"), IgnoredPaths: f.strings("ignoredPaths")}
+		return nil
+	})
+	return filter
+}
+
+func decodeAutoscaling(m *yamlMapping) *Autoscaling {
+	var autoscaling *Autoscaling
+	m.mapping("scaling", func(s *yamlMapping) error {
+		autoscaling = &Autoscaling{Enabled: true}
+		if v := s.int64("minInstances"); v != nil {
+			autoscaling.Min = *v
+		}
+		if v := s.int64("maxInstances"); v != nil {
+			autoscaling.Max = *v
+		}
+		autoscaling.Criteria.CPU = decodeAutoscalingTarget(s.int64("targetCPUPercent"))
+		autoscaling.Criteria.Memory = decodeAutoscalingTarget(s.int64("targetMemoryPercent"))
+		return nil
+	})
+	return autoscaling
+}
+
+func decodeAutoscalingTarget(percent *int64) AutoscalingTarget {
+	if percent == nil {
+		return AutoscalingTarget{Percentage: defaultTargetPercent}
+	}
+	return AutoscalingTarget{Enabled: true, Percentage: *percent}
+}
+
+func decodeDisk(m *yamlMapping) *Disk {
+	var disk *Disk
+	m.mapping("disk", func(d *yamlMapping) error {
+		disk = &Disk{
+			Name:      d.requiredString("name"),
+			MountPath: d.requiredString("mountPath"),
+			SizeGB:    defaultDiskSizeGB,
+		}
+		if size := d.int64("sizeGB"); size != nil {
+			disk.SizeGB = *size
+		}
+		return nil
+	})
+	return disk
+}
+
+func decodeCustomDomains(m *yamlMapping) []CustomDomain {
+	var domains []CustomDomain
+	for _, name := range m.strings("domains") {
+		domains = append(domains, CustomDomain{Name: name})
+	}
+	return domains
+}
+
+func decodePreviews(m *yamlMapping) *Previews {
+	var previews *Previews
+	m.mapping("previews", func(p *yamlMapping) error {
+		previews = &Previews{Generation: p.string("generation")}
+		return nil
+	})
+	return previews
+}
+
+func decodeIPAllowList(m *yamlMapping) []IPAllowListEntry {
+	var entries []IPAllowListEntry
+	m.sequence("ipAllowList", func(n *yaml.Node, path string) error {
+		return decodeMapping(n, path, func(e *yamlMapping) error {
+			entries = append(entries, IPAllowListEntry{
+				CIDRBlock:   e.requiredString("source"),
+				Description: e.string("description"),
+			})
+			return nil
+		})
+	})
+	return entries
+}
+
+// decodeEnvVars reads plain and generated env vars. References to other
+// objects, such as fromDatabase, and sync: false have no equivalent in
+// env_vars, so they are reported as unsupported.
+func decodeEnvVars(m *yamlMapping) map[string]EnvVar {
+	if !m.has("envVars") {
+		return nil
+	}
+
+	evs := map[string]EnvVar{}
+	m.sequence("envVars", func(n *yaml.Node, path string) error {
+		return decodeMapping(n, path, func(e *yamlMapping) error {
+			key := e.requiredString("key")
+			ev := EnvVar{Value: e.string("value"), GenerateValue: e.bool("generateValue")}
+			if e.err != nil {
+				return e.err
+			}
+			if _, ok := evs[key]; ok {
+				return errorAt(e.at("key"), e.values["key"], "duplicate env var %q", key)
+			}
+			evs[key] = ev
+			return nil
+		})
+	})
+	return evs
+}
+
+var _ function.Function = &decodeBlueprintFunction{}
+
+func NewDecodeBlueprintFunction() function.Function {
+	return &decodeBlueprintFunction{}
+}
+
+type decodeBlueprintFunction struct{}
+
+func (f *decodeBlueprintFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decode_blueprint"
+}
+
+func (f *decodeBlueprintFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Decode a render.yaml Blueprint",
+		Description:         "Decodes a render.yaml Blueprint into maps of web_services, private_services, background_workers, cron_jobs, static_sites, postgres, keyvalue and env_groups keyed by name. Attribute names match the schemas of the corresponding resources, and attributes the Blueprint does not set are null. Keys that have no equivalent in the provider, such as fromDatabase env vars or sync: false, produce an error with their path in the document.",
+		MarkdownDescription: "Decodes a `render.yaml` Blueprint into maps of `web_services`, `private_services`, `background_workers`, `cron_jobs`, `static_sites`, `postgres`, `keyvalue` and `env_groups` keyed by name. Attribute names match the schemas of the corresponding resources, and attributes the Blueprint does not set are null. Keys that have no equivalent in the provider, such as `fromDatabase` env vars or `sync: false`, produce an error with their path in the document.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "content",
+				Description: "Content of a render.yaml file.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: objectAttrTypes(reflect.TypeFor[Blueprint]())},
+	}
+}
+
+func (f *decodeBlueprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	resp.Error = req.Arguments.Get(ctx, &content)
+	if resp.Error != nil {
+		return
+	}
+
+	bp, err := DecodeBlueprint(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, bp)
+}
+
+// objectAttrTypes derives the attribute types of an object from the tfsdk tags
+// of a struct, so that the return type of a function cannot drift from the Go
+// type it is set from.
+func objectAttrTypes(t reflect.Type) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		attrTypes[field.Tag.Get("tfsdk")] = attrTypeOf(field.Type)
+	}
+	return attrTypes
+}
+
+func attrTypeOf(t reflect.Type) attr.Type {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return attrTypeOf(t.Elem())
+	case reflect.String:
+		return types.StringType
+	case reflect.Bool:
+		return types.BoolType
+	case reflect.Int64:
+		return types.Int64Type
+	case reflect.Slice:
+		return types.ListType{ElemType: attrTypeOf(t.Elem())}
+	case reflect.Map:
+		return types.MapType{ElemType: attrTypeOf(t.Elem())}
+	case reflect.Struct:
+		return types.ObjectType{AttrTypes: objectAttrTypes(t)}
+	}
+	panic(fmt.Sprintf("unsupported type %s", t))
+}
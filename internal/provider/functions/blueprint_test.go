@@ -0,0 +1,254 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/provider/functions"
+)
+
+const blueprint = `
+services:
+  - type: web
+    name: api
+    runtime: node
+    plan: starter
+    region: oregon
+    repo: https://github.com/render-examples/express-hello-world
+    branch: main
+    buildCommand: npm install
+    startCommand: npm start
+    healthCheckPath: /healthz
+    autoDeployTrigger: commit
+    buildFilter:
+      paths: ["src/**"]
+    scaling:
+      minInstances: 1
+      maxInstances: 3
+      targetCPUPercent: 60
+    domains:
+      - api.example.com
+    envVars:
+      - key: NODE_ENV
+        value: production
+      - key: PORT
+        value: 10000
+      - key: SESSION_SECRET
+        generateValue: true
+  - type: worker
+    name: jobs
+    runtime: docker
+    dockerfilePath: ./Dockerfile.worker
+    dockerCommand: ./worker
+    repo: https://github.com/example/jobs
+    disk:
+      name: data
+      mountPath: /data
+  - type: cron
+    name: nightly
+    runtime: image
+    image:
+      url: docker.io/example/nightly:1.2.3
+    schedule: "0 3 * * *"
+  - type: web
+    name: docs
+    runtime: static
+    repo: https://github.com/example/docs
+    buildCommand: npm run build
+    staticPublishPath: ./build
+    headers:
+      - path: /*
+        name: X-Frame-Options
+        value: DENY
+    routes:
+      - type: rewrite
+        source: /*
+        destination: /index.html
+  - type: keyvalue
+    name: cache
+    plan: starter
+    maxmemoryPolicy: allkeys-lru
+    ipAllowList:
+      - source: 0.0.0.0/0
+        description: everywhere
+databases:
+  - name: db
+    databaseName: app
+    user: app
+    plan: basic-256mb
+    postgresMajorVersion: "16"
+    ipAllowList: []
+envVarGroups:
+  - name: shared
+    envVars:
+      - key: LOG_LEVEL
+        value: info
+`
+
+func TestDecodeBlueprint(t *testing.T) {
+	bp, err := functions.DecodeBlueprint(blueprint)
+	require.NoError(t, err)
+
+	api := bp.WebServices["api"]
+	assert.Equal(t, "api", api.Name)
+	assert.Equal(t, ptr("starter"), api.Plan)
+	require.NotNil(t, api.RuntimeSource.NativeRuntime)
+	assert.Equal(t, functions.NativeRuntime{
+		Runtime:           "node",
+		RepoURL:           ptr("https://github.com/render-examples/express-hello-world"),
+		Branch:            ptr("main"),
+		BuildCommand:      ptr("npm install"),
+		BuildFilter:       &functions.BuildFilter{Paths: []string{"src/**"}},
+		AutoDeployTrigger: ptr("commit"),
+	}, *api.RuntimeSource.NativeRuntime)
+	assert.Equal(t, &functions.Autoscaling{
+		Enabled: true,
+		Min:     1,
+		Max:     3,
+		Criteria: functions.AutoscalingCriteria{
+			CPU:    functions.AutoscalingTarget{Enabled: true, Percentage: 60},
+			Memory: functions.AutoscalingTarget{Percentage: 90},
+		},
+	}, api.Autoscaling)
+	assert.Equal(t, []functions.CustomDomain{{Name: "api.example.com"}}, api.CustomDomains)
+	assert.Equal(t, map[string]functions.EnvVar{
+		"NODE_ENV":       {Value: ptr("production")},
+		"PORT":           {Value: ptr("10000")},
+		"SESSION_SECRET": {GenerateValue: ptr(true)},
+	}, api.EnvVars)
+
+	jobs := bp.BackgroundWorkers["jobs"]
+	require.NotNil(t, jobs.RuntimeSource.Docker)
+	assert.Equal(t, ptr("./Dockerfile.worker"), jobs.RuntimeSource.Docker.DockerfilePath)
+	assert.Equal(t, ptr("./worker"), jobs.StartCommand)
+	assert.Equal(t, &functions.Disk{Name: "data", MountPath: "/data", SizeGB: 10}, jobs.Disk)
+
+	nightly := bp.CronJobs["nightly"]
+	assert.Equal(t, "0 3 * * *", nightly.Schedule)
+	assert.Equal(t, &functions.Image{ImageURL: "docker.io/example/nightly", Tag: ptr("1.2.3")}, nightly.RuntimeSource.Image)
+
+	docs := bp.StaticSites["docs"]
+	assert.Equal(t, ptr("./build"), docs.PublishPath)
+	assert.Equal(t, []functions.Header{{Path: "/*", Name: "X-Frame-Options", Value: "DENY"}}, docs.Headers)
+	assert.Equal(t, []functions.Route{{Type: "rewrite", Source: "/*", Destination: "/index.html"}}, docs.Routes)
+
+	assert.Equal(t, functions.KeyValue{
+		Name:            "cache",
+		Plan:            ptr("starter"),
+		MaxMemoryPolicy: ptr("allkeys-lru"),
+		IPAllowList:     []functions.IPAllowListEntry{{CIDRBlock: "0.0.0.0/0", Description: ptr("everywhere")}},
+	}, bp.KeyValue["cache"])
+
+	assert.Equal(t, ptr("16"), bp.Postgres["db"].Version)
+	assert.Equal(t, ptr("app"), bp.Postgres["db"].DatabaseUser)
+
+	assert.Equal(t, map[string]functions.EnvVar{"LOG_LEVEL": {Value: ptr("info")}}, bp.EnvGroups["shared"].EnvVars)
+	assert.Empty(t, bp.PrivateServices)
+}
+
+func TestDecodeBlueprintErrors(t *testing.T) {
+	tcs := []struct {
+		name     string
+		yaml     string
+		expected string
+	}{
+		{
+			name: "env var reference",
+			yaml: `
+services:
+  - type: web
+    name: api
+    runtime: node
+    envVars:
+      - key: DATABASE_URL
+        fromDatabase:
+          name: db
+          property: connectionString
+`,
+			expected: "services[0].envVars[0].fromDatabase (line 8): unsupported key",
+		},
+		{
+			name: "unknown top-level key",
+			yaml: `
+projects:
+  - name: app
+`,
+			expected: "projects (line 2): unsupported key",
+		},
+		{
+			name: "unknown service type",
+			yaml: `
+services:
+  - type: function
+    name: f
+    runtime: node
+`,
+			expected: `services[0].type (line 3): unsupported service type "function"`,
+		},
+		{
+			name: "missing schedule",
+			yaml: `
+services:
+  - type: cron
+    name: nightly
+    runtime: go
+`,
+			expected: "services[0].schedule (line 3): required key is missing",
+		},
+		{
+			name: "wrong type",
+			yaml: `
+databases:
+  - name: db
+    diskSizeGB: large
+`,
+			expected: "databases[0].diskSizeGB (line 4): expected an integer",
+		},
+		{
+			name: "duplicate name",
+			yaml: `
+envVarGroups:
+  - name: shared
+  - name: shared
+`,
+			expected: `envVarGroups[1].name (line 4): duplicate name "shared"`,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := functions.DecodeBlueprint(tc.yaml)
+			assert.EqualError(t, err, tc.expected)
+		})
+	}
+}
+
+func TestDecodeBlueprintFunction(t *testing.T) {
+	ctx := context.Background()
+	f := functions.NewDecodeBlueprintFunction()
+
+	var defResp function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &defResp)
+	returnType := defResp.Definition.Return.GetType()
+
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(returnType.(types.ObjectType).AttrTypes))}
+	f.Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(blueprint)}),
+	}, &resp)
+	require.Nil(t, resp.Error)
+
+	result, ok := resp.Result.Value().(types.Object)
+	require.True(t, ok)
+	webServices, ok := result.Attributes()["web_services"].(types.Map)
+	require.True(t, ok)
+	api, ok := webServices.Elements()["api"].(types.Object)
+	require.True(t, ok)
+	assert.Equal(t, types.StringValue("/healthz"), api.Attributes()["health_check_path"])
+	assert.True(t, api.Attributes()["disk"].IsNull())
+}
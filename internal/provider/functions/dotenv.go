@@ -0,0 +1,367 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dotenvKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// DecodeDotenv parses the content of a .env file. Values may be unquoted,
+// single-quoted, double-quoted or backtick-quoted, and quoted values may span
+// several lines. Only double-quoted values support escapes. Lines may start
+// with export, and comments start with #. Variables are not expanded, and a
+// key that is set twice keeps its last value.
+func DecodeDotenv(content string) (map[string]string, error) {
+	p := dotenvParser{s: strings.ReplaceAll(content, "\r\n", "\n"), line: 1}
+	res := map[string]string{}
+
+	for {
+		p.skipBlankAndComments()
+		if p.eof() {
+			return res, nil
+		}
+
+		line := p.line
+		key, value, err := p.entry()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		res[key] = value
+	}
+}
+
+type dotenvParser struct {
+	s    string
+	pos  int
+	line int
+}
+
+func (p *dotenvParser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *dotenvParser) peek() byte {
+	return p.s[p.pos]
+}
+
+func (p *dotenvParser) next() byte {
+	c := p.s[p.pos]
+	p.pos++
+	if c == '\n' {
+		p.line++
+	}
+	return c
+}
+
+func (p *dotenvParser) skipSpaces() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.next()
+	}
+}
+
+func (p *dotenvParser) skipToEndOfLine() {
+	for !p.eof() && p.peek() != '\n' {
+		p.next()
+	}
+}
+
+func (p *dotenvParser) skipBlankAndComments() {
+	for !p.eof() {
+		switch p.peek() {
+		case ' ', '\t', '\n':
+			p.next()
+		case '#':
+			p.skipToEndOfLine()
+		default:
+			return
+		}
+	}
+}
+
+// entry parses a single KEY=value entry and the rest of its last line.
+func (p *dotenvParser) entry() (string, string, error) {
+	start := p.pos
+	for !p.eof() && p.peek() != '=' && p.peek() != '\n' {
+		p.next()
+	}
+	if p.eof() || p.peek() != '=' {
+		return "", "", fmt.Errorf("expected KEY=value, got %q", strings.TrimSpace(p.s[start:p.pos]))
+	}
+
+	key := strings.TrimSpace(p.s[start:p.pos])
+	if rest, ok := strings.CutPrefix(key, "export"); ok && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+		key = strings.TrimSpace(rest)
+	}
+	if !dotenvKey.MatchString(key) {
+		return "", "", fmt.Errorf("invalid key %q", key)
+	}
+	p.next() // =
+	p.skipSpaces()
+
+	if p.eof() || p.peek() == '\n' {
+		return key, "", nil
+	}
+
+	var value string
+	var err error
+	switch quote := p.peek(); quote {
+	case '\'', '`':
+		value, err = p.literal(quote)
+	case '"':
+		value, err = p.doubleQuoted()
+	default:
+		return key, p.unquoted(), nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	p.skipSpaces()
+	if !p.eof() && p.peek() != '\n' && p.peek() != '#' {
+		return "", "", fmt.Errorf("unexpected %q after the closing quote of %s", p.peek(), key)
+	}
+	p.skipToEndOfLine()
+	return key, value, nil
+}
+
+// unquoted reads the rest of the line, dropping a trailing comment.
+func (p *dotenvParser) unquoted() string {
+	start := p.pos
+	p.skipToEndOfLine()
+	value := p.s[start:p.pos]
+
+	for i := 1; i < len(value); i++ {
+		if value[i] == '#' && (value[i-1] == ' ' || value[i-1] == '\t') {
+			value = value[:i]
+			break
+		}
+	}
+	return strings.TrimSpace(value)
+}
+
+// literal reads a value quoted with quote, which has no escapes.
+func (p *dotenvParser) literal(quote byte) (string, error) {
+	p.next()
+	start := p.pos
+	for !p.eof() {
+		if p.peek() == quote {
+			value := p.s[start:p.pos]
+			p.next()
+			return value, nil
+		}
+		p.next()
+	}
+	return "", fmt.Errorf("missing closing %c", quote)
+}
+
+var dotenvEscapes = map[byte]byte{'n': '\n', 'r': '\r', 't': '\t', '"': '"', '\\': '\\', '$': '$'}
+
+func (p *dotenvParser) doubleQuoted() (string, error) {
+	p.next()
+	var b strings.Builder
+	for !p.eof() {
+		c := p.next()
+		switch c {
+		case '"':
+			return b.String(), nil
+		case '\\':
+			if p.eof() {
+				break
+			}
+			e := p.next()
+			if r, ok := dotenvEscapes[e]; ok {
+				b.WriteByte(r)
+			} else {
+				b.WriteByte('\\')
+				b.WriteByte(e)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("missing closing %c", '"')
+}
+
+// EncodeDotenv renders env vars as the content of a .env file, sorted by key.
+// Values that would not survive an unquoted round trip are double-quoted.
+func EncodeDotenv(evs map[string]string) (string, error) {
+	var b strings.Builder
+	for _, key := range slices.Sorted(maps.Keys(evs)) {
+		if !dotenvKey.MatchString(key) {
+			return "", fmt.Errorf("invalid key %q", key)
+		}
+		b.WriteString(key)
+		b.WriteByte('=')
+		b.WriteString(encodeDotenvValue(evs[key]))
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
+
+func encodeDotenvValue(v string) string {
+	if v != "" && !strings.ContainsAny(v, " \t\n\r#\"'`\\$=") {
+		return v
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(v); i++ {
+		switch c := v[i]; c {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '"', '\\', '$':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+var envVarAttrTypes = objectAttrTypes(reflect.TypeFor[EnvVar]())
+
+var _ function.Function = &dotenvDecodeFunction{}
+
+func NewDotenvDecodeFunction() function.Function {
+	return &dotenvDecodeFunction{}
+}
+
+type dotenvDecodeFunction struct{}
+
+func (f *dotenvDecodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dotenv_decode"
+}
+
+func (f *dotenvDecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Decode a .env file into env_vars",
+		Description:         "Parses the content of a .env file into a map that can be used as env_vars of a service or environment group. Values may be unquoted, single-quoted, double-quoted or backtick-quoted, and quoted values may span several lines. Only double-quoted values support escapes such as \\n. Lines may start with export, and comments start with #. Variables are not expanded.",
+		MarkdownDescription: "Parses the content of a `.env` file into a map that can be used as `env_vars` of a service or environment group. Values may be unquoted, single-quoted, double-quoted or backtick-quoted, and quoted values may span several lines. Only double-quoted values support escapes such as `\\n`. Lines may start with `export`, and comments start with `#`. Variables are not expanded.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "content",
+				Description: "Content of a .env file.",
+			},
+		},
+		Return: function.MapReturn{ElementType: types.ObjectType{AttrTypes: envVarAttrTypes}},
+	}
+}
+
+func (f *dotenvDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	resp.Error = req.Arguments.Get(ctx, &content)
+	if resp.Error != nil {
+		return
+	}
+
+	values, err := DecodeDotenv(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	evs := make(map[string]EnvVar, len(values))
+	for k, v := range values {
+		evs[k] = EnvVar{Value: &v}
+	}
+
+	resp.Error = resp.Result.Set(ctx, evs)
+}
+
+var _ function.Function = &dotenvEncodeFunction{}
+
+func NewDotenvEncodeFunction() function.Function {
+	return &dotenvEncodeFunction{}
+}
+
+type dotenvEncodeFunction struct{}
+
+func (f *dotenvEncodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dotenv_encode"
+}
+
+func (f *dotenvEncodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Encode env vars as a .env file",
+		Description:         "Renders a map as the content of a .env file, for example for the content of a secret file. The map may hold strings or objects with a value attribute, such as env_vars or the result of dotenv_decode. Keys are sorted, and values are double-quoted when needed.",
+		MarkdownDescription: "Renders a map as the content of a `.env` file, for example for the `content` of a secret file. The map may hold strings or objects with a `value` attribute, such as `env_vars` or the result of `dotenv_decode`. Keys are sorted, and values are double-quoted when needed.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "env_vars",
+				Description: "Map of strings, or of objects with a value attribute.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *dotenvEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &arg)
+	if resp.Error != nil {
+		return
+	}
+
+	values, err := dotenvValues(arg.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	content, err := EncodeDotenv(values)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, content)
+}
+
+// dotenvValues reads the values of a map or object of strings, or of objects
+// with a value attribute.
+func dotenvValues(v attr.Value) (map[string]string, error) {
+	var elems map[string]attr.Value
+	switch v := v.(type) {
+	case types.Map:
+		elems = v.Elements()
+	case types.Object:
+		elems = v.Attributes()
+	default:
+		return nil, fmt.Errorf("expected a map, got %s", v.Type(context.Background()))
+	}
+
+	res := make(map[string]string, len(elems))
+	for k, elem := range elems {
+		if obj, ok := elem.(types.Object); ok {
+			value, ok := obj.Attributes()["value"]
+			if !ok {
+				return nil, fmt.Errorf("%s: expected a string or an object with a value attribute", k)
+			}
+			elem = value
+		}
+
+		s, ok := elem.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a string or an object with a value attribute", k)
+		}
+		if s.IsNull() {
+			return nil, fmt.Errorf("%s: value is null, generated and write-only values cannot be encoded", k)
+		}
+		res[k] = s.ValueString()
+	}
+	return res, nil
+}
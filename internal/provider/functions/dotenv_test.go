@@ -0,0 +1,145 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/provider/functions"
+)
+
+func TestDecodeDotenv(t *testing.T) {
+	content := `# Database settings
+export DATABASE_URL=postgres://localhost/app
+PLAIN = some value # trailing comment
+HASH=abc#def
+EMPTY=
+SINGLE='no \n escapes # here'
+DOUBLE="line one\nline two \"quoted\""
+MULTILINE="-----BEGIN KEY-----
+abc
+-----END KEY-----"
+BACKTICK=` + "`it's \"raw\"`" + `
+DUPLICATE=first
+DUPLICATE=second
+`
+
+	values, err := functions.DecodeDotenv(content)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"DATABASE_URL": "postgres://localhost/app",
+		"PLAIN":        "some value",
+		"HASH":         "abc#def",
+		"EMPTY":        "",
+		"SINGLE":       `no \n escapes # here`,
+		"DOUBLE":       "line one\nline two \"quoted\"",
+		"MULTILINE":    "-----BEGIN KEY-----\nabc\n-----END KEY-----",
+		"BACKTICK":     `it's "raw"`,
+		"DUPLICATE":    "second",
+	}, values)
+}
+
+func TestDecodeDotenvErrors(t *testing.T) {
+	tcs := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "missing equals", content: "A=1\nB\n", expected: `line 2: expected KEY=value, got "B"`},
+		{name: "invalid key", content: "1A=1\n", expected: `line 1: invalid key "1A"`},
+		{name: "unterminated quote", content: "A=\"abc\n", expected: `line 1: missing closing "`},
+		{name: "text after quote", content: "A='abc' def\n", expected: `line 1: unexpected 'd' after the closing quote of A`},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := functions.DecodeDotenv(tc.content)
+			assert.EqualError(t, err, tc.expected)
+		})
+	}
+}
+
+func TestEncodeDotenv(t *testing.T) {
+	values := map[string]string{
+		"PLAIN":     "value",
+		"SPACES":    "some value",
+		"EMPTY":     "",
+		"MULTILINE": "line one\nline two",
+		"SPECIAL":   `a "quoted" $HOME \ path`,
+	}
+
+	content, err := functions.EncodeDotenv(values)
+	require.NoError(t, err)
+	assert.Equal(t, `EMPTY=""
+MULTILINE="line one\nline two"
+PLAIN=value
+SPACES="some value"
+SPECIAL="a \"quoted\" \$HOME \\ path"
+`, content)
+
+	decoded, err := functions.DecodeDotenv(content)
+	require.NoError(t, err)
+	assert.Equal(t, values, decoded)
+}
+
+func TestDotenvFunctions(t *testing.T) {
+	ctx := context.Background()
+	envVarType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"value":          types.StringType,
+		"generate_value": types.BoolType,
+	}}
+
+	t.Run("dotenv_decode returns env_vars", func(t *testing.T) {
+		resp := function.RunResponse{Result: function.NewResultData(types.MapUnknown(envVarType))}
+		functions.NewDotenvDecodeFunction().Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("A=1\n")}),
+		}, &resp)
+		require.Nil(t, resp.Error)
+
+		expected := types.MapValueMust(envVarType, map[string]attr.Value{
+			"A": types.ObjectValueMust(envVarType.AttrTypes, map[string]attr.Value{
+				"value":          types.StringValue("1"),
+				"generate_value": types.BoolNull(),
+			}),
+		})
+		assert.Equal(t, expected, resp.Result.Value())
+	})
+
+	t.Run("dotenv_encode accepts strings and env_vars", func(t *testing.T) {
+		arg := types.ObjectValueMust(
+			map[string]attr.Type{"A": types.StringType, "B": types.ObjectType{AttrTypes: map[string]attr.Type{"value": types.StringType}}},
+			map[string]attr.Value{
+				"A": types.StringValue("1"),
+				"B": types.ObjectValueMust(map[string]attr.Type{"value": types.StringType}, map[string]attr.Value{"value": types.StringValue("two words")}),
+			},
+		)
+
+		resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		functions.NewDotenvEncodeFunction().Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(arg)}),
+		}, &resp)
+		require.Nil(t, resp.Error)
+		assert.Equal(t, types.StringValue("A=1\nB=\"two words\"\n"), resp.Result.Value())
+	})
+
+	t.Run("dotenv_encode rejects generated values", func(t *testing.T) {
+		arg := types.MapValueMust(envVarType, map[string]attr.Value{
+			"SECRET": types.ObjectValueMust(envVarType.AttrTypes, map[string]attr.Value{
+				"value":          types.StringNull(),
+				"generate_value": types.BoolValue(true),
+			}),
+		})
+
+		resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		functions.NewDotenvEncodeFunction().Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(arg)}),
+		}, &resp)
+		require.NotNil(t, resp.Error)
+		assert.Contains(t, resp.Error.Text, "SECRET: value is null")
+	})
+}
@@ -0,0 +1,212 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultPostgresPort = 5432
+
+// sslModes are the sslmode values libpq accepts. The last three require TLS.
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// PostgresURL is the parsed form of a Postgres connection string.
+type PostgresURL struct {
+	Host     string  `tfsdk:"host"`
+	Port     int64   `tfsdk:"port"`
+	User     string  `tfsdk:"user"`
+	Password string  `tfsdk:"password"`
+	Database string  `tfsdk:"database"`
+	SSLMode  *string `tfsdk:"sslmode"`
+	SSL      bool    `tfsdk:"ssl"`
+}
+
+var postgresURLAttributeTypes = map[string]attr.Type{
+	"host":     types.StringType,
+	"port":     types.Int64Type,
+	"user":     types.StringType,
+	"password": types.StringType,
+	"database": types.StringType,
+	"sslmode":  types.StringType,
+	"ssl":      types.BoolType,
+}
+
+// ParsePostgresURL parses a postgres:// or postgresql:// connection string,
+// such as the ones in the connection_info of render_postgres.
+func ParsePostgresURL(s string) (PostgresURL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return PostgresURL{}, fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+		return PostgresURL{}, fmt.Errorf("expected a postgres:// or postgresql:// URL, got scheme %q", u.Scheme)
+	}
+
+	port, err := parsePort(u, defaultPostgresPort)
+	if err != nil {
+		return PostgresURL{}, err
+	}
+
+	res := PostgresURL{
+		Host:     u.Hostname(),
+		Port:     port,
+		User:     u.User.Username(),
+		Database: strings.TrimPrefix(u.Path, "/"),
+	}
+	res.Password, _ = u.User.Password()
+
+	if mode := u.Query().Get("sslmode"); mode != "" {
+		if !slices.Contains(sslModes, mode) {
+			return PostgresURL{}, fmt.Errorf("unsupported sslmode %q, expected one of %s", mode, strings.Join(sslModes, ", "))
+		}
+		res.SSLMode = &mode
+		res.SSL = mode == "require" || mode == "verify-ca" || mode == "verify-full"
+	}
+
+	return res, nil
+}
+
+// BuildPostgresURL builds a postgresql:// connection string, escaping the
+// user, password and database as needed.
+func BuildPostgresURL(p PostgresURL) (string, error) {
+	if p.Host == "" {
+		return "", fmt.Errorf("host must not be empty")
+	}
+	if p.Port < 1 || p.Port > 65535 {
+		return "", fmt.Errorf("port must be between 1 and 65535, got %d", p.Port)
+	}
+
+	u := url.URL{
+		Scheme: "postgresql",
+		Host:   net.JoinHostPort(p.Host, strconv.FormatInt(p.Port, 10)),
+		Path:   "/" + p.Database,
+	}
+	switch {
+	case p.Password != "":
+		u.User = url.UserPassword(p.User, p.Password)
+	case p.User != "":
+		u.User = url.User(p.User)
+	}
+
+	if p.SSLMode != nil {
+		if !slices.Contains(sslModes, *p.SSLMode) {
+			return "", fmt.Errorf("unsupported sslmode %q, expected one of %s", *p.SSLMode, strings.Join(sslModes, ", "))
+		}
+		u.RawQuery = url.Values{"sslmode": []string{*p.SSLMode}}.Encode()
+	}
+
+	return u.String(), nil
+}
+
+func parsePort(u *url.URL, defaultPort int64) (int64, error) {
+	if u.Port() == "" {
+		return defaultPort, nil
+	}
+
+	port, err := strconv.ParseInt(u.Port(), 10, 64)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid port %q", u.Port())
+	}
+	return port, nil
+}
+
+var _ function.Function = &parsePostgresURLFunction{}
+
+func NewParsePostgresURLFunction() function.Function {
+	return &parsePostgresURLFunction{}
+}
+
+type parsePostgresURLFunction struct{}
+
+func (f *parsePostgresURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_postgres_url"
+}
+
+func (f *parsePostgresURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a Postgres connection string",
+		Description:         "Splits a postgres:// or postgresql:// connection string into host, port, user, password, database, sslmode and ssl. The port defaults to 5432. sslmode is null when the URL does not set it, and ssl is true when sslmode is require, verify-ca or verify-full.",
+		MarkdownDescription: "Splits a `postgres://` or `postgresql://` connection string into `host`, `port`, `user`, `password`, `database`, `sslmode` and `ssl`. The port defaults to `5432`. `sslmode` is null when the URL does not set it, and `ssl` is `true` when `sslmode` is `require`, `verify-ca` or `verify-full`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "Postgres connection string, such as connection_info.external_connection_string of render_postgres.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: postgresURLAttributeTypes},
+	}
+}
+
+func (f *parsePostgresURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := ParsePostgresURL(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, parsed)
+}
+
+var _ function.Function = &buildPostgresURLFunction{}
+
+func NewBuildPostgresURLFunction() function.Function {
+	return &buildPostgresURLFunction{}
+}
+
+type buildPostgresURLFunction struct{}
+
+func (f *buildPostgresURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_postgres_url"
+}
+
+func (f *buildPostgresURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a Postgres connection string",
+		Description:         "Builds a postgresql:// connection string from its parts, escaping the user, password and database as needed. The sslmode query parameter is only added when sslmode is not null.",
+		MarkdownDescription: "Builds a `postgresql://` connection string from its parts, escaping the user, password and database as needed. The `sslmode` query parameter is only added when `sslmode` is not null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "host", Description: "Host name of the database."},
+			function.Int64Parameter{Name: "port", Description: "Port of the database, usually 5432."},
+			function.StringParameter{Name: "user", Description: "User to connect as."},
+			function.StringParameter{Name: "password", Description: "Password of the user."},
+			function.StringParameter{Name: "database", Description: "Name of the database."},
+			function.StringParameter{
+				Name:           "sslmode",
+				Description:    "One of disable, allow, prefer, require, verify-ca or verify-full, or null to leave it out.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildPostgresURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var p PostgresURL
+	resp.Error = req.Arguments.Get(ctx, &p.Host, &p.Port, &p.User, &p.Password, &p.Database, &p.SSLMode)
+	if resp.Error != nil {
+		return
+	}
+
+	s, err := BuildPostgresURL(p)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, s)
+}
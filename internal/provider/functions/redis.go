@@ -0,0 +1,111 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultRedisPort = 6379
+
+// RedisURL is the parsed form of a Redis or Key Value connection string.
+type RedisURL struct {
+	Host     string `tfsdk:"host"`
+	Port     int64  `tfsdk:"port"`
+	Username string `tfsdk:"username"`
+	Password string `tfsdk:"password"`
+	Database int64  `tfsdk:"database"`
+	TLS      bool   `tfsdk:"tls"`
+}
+
+var redisURLAttributeTypes = map[string]attr.Type{
+	"host":     types.StringType,
+	"port":     types.Int64Type,
+	"username": types.StringType,
+	"password": types.StringType,
+	"database": types.Int64Type,
+	"tls":      types.BoolType,
+}
+
+// ParseRedisURL parses a redis:// or rediss:// connection string, such as the
+// ones in the connection_info of render_keyvalue. rediss:// URLs use TLS.
+func ParseRedisURL(s string) (RedisURL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return RedisURL{}, fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Scheme != "redis" && u.Scheme != "rediss" {
+		return RedisURL{}, fmt.Errorf("expected a redis:// or rediss:// URL, got scheme %q", u.Scheme)
+	}
+
+	port, err := parsePort(u, defaultRedisPort)
+	if err != nil {
+		return RedisURL{}, err
+	}
+
+	res := RedisURL{
+		Host:     u.Hostname(),
+		Port:     port,
+		Username: u.User.Username(),
+		TLS:      u.Scheme == "rediss",
+	}
+	res.Password, _ = u.User.Password()
+
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		res.Database, err = strconv.ParseInt(db, 10, 64)
+		if err != nil || res.Database < 0 {
+			return RedisURL{}, fmt.Errorf("invalid database number %q", db)
+		}
+	}
+
+	return res, nil
+}
+
+var _ function.Function = &parseRedisURLFunction{}
+
+func NewParseRedisURLFunction() function.Function {
+	return &parseRedisURLFunction{}
+}
+
+type parseRedisURLFunction struct{}
+
+func (f *parseRedisURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_redis_url"
+}
+
+func (f *parseRedisURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a Redis or Key Value connection string",
+		Description:         "Splits a redis:// or rediss:// connection string into host, port, username, password, database and tls. The port defaults to 6379 and the database to 0. tls is true for rediss:// URLs.",
+		MarkdownDescription: "Splits a `redis://` or `rediss://` connection string into `host`, `port`, `username`, `password`, `database` and `tls`. The port defaults to `6379` and the database to `0`. `tls` is `true` for `rediss://` URLs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "Redis connection string, such as connection_info.external_connection_string of render_keyvalue.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: redisURLAttributeTypes},
+	}
+}
+
+func (f *parseRedisURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := ParseRedisURL(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, parsed)
+}
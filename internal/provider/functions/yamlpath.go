@@ -0,0 +1,185 @@
+package functions
+
+import (
+	"fmt"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlError is an error at a path in a YAML document, such as
+// services[0].envVars[2].fromDatabase.
+type yamlError struct {
+	path string
+	line int
+	msg  string
+}
+
+func (e *yamlError) Error() string {
+	if e.line > 0 {
+		return fmt.Sprintf("%s (line %d): %s", e.path, e.line, e.msg)
+	}
+	return fmt.Sprintf("%s: %s", e.path, e.msg)
+}
+
+func errorAt(path string, n *yaml.Node, format string, args ...any) error {
+	e := &yamlError{path: path, msg: fmt.Sprintf(format, args...)}
+	if n != nil {
+		e.line = n.Line
+	}
+	return e
+}
+
+// yamlMapping reads the keys of a YAML mapping. Every key has to be read before
+// done is called, so that keys the decoder does not know about are reported
+// instead of being dropped.
+type yamlMapping struct {
+	path   string
+	self   *yaml.Node
+	keys   []*yaml.Node
+	values map[string]*yaml.Node
+	used   map[string]bool
+	err    error
+}
+
+func newYAMLMapping(n *yaml.Node, path string) (*yamlMapping, error) {
+	if n.Kind != yaml.MappingNode {
+		return nil, errorAt(path, n, "expected a mapping")
+	}
+
+	m := &yamlMapping{path: path, self: n, values: map[string]*yaml.Node{}, used: map[string]bool{}}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		m.keys = append(m.keys, n.Content[i])
+		m.values[n.Content[i].Value] = n.Content[i+1]
+	}
+	return m, nil
+}
+
+func (m *yamlMapping) at(key string) string {
+	if m.path == "" {
+		return key
+	}
+	return m.path + "." + key
+}
+
+// has reports whether key is set, without marking it as read.
+func (m *yamlMapping) has(key string) bool {
+	_, ok := m.values[key]
+	return ok
+}
+
+func (m *yamlMapping) node(key string) *yaml.Node {
+	m.used[key] = true
+	return m.values[key]
+}
+
+func (m *yamlMapping) string(key string) *string {
+	n := m.node(key)
+	if n == nil || m.err != nil {
+		return nil
+	}
+	if n.Kind != yaml.ScalarNode {
+		m.err = errorAt(m.at(key), n, "expected a string")
+		return nil
+	}
+	return &n.Value
+}
+
+func (m *yamlMapping) requiredString(key string) string {
+	s := m.string(key)
+	if s == nil {
+		if m.err == nil {
+			m.err = errorAt(m.at(key), m.self, "required key is missing")
+		}
+		return ""
+	}
+	return *s
+}
+
+func (m *yamlMapping) bool(key string) *bool {
+	return decodeScalar[bool](m, key, "a boolean")
+}
+
+func (m *yamlMapping) int64(key string) *int64 {
+	return decodeScalar[int64](m, key, "an integer")
+}
+
+func decodeScalar[T any](m *yamlMapping, key, expected string) *T {
+	n := m.node(key)
+	if n == nil || m.err != nil {
+		return nil
+	}
+
+	var v T
+	if n.Kind != yaml.ScalarNode || n.Decode(&v) != nil {
+		m.err = errorAt(m.at(key), n, "expected %s", expected)
+		return nil
+	}
+	return &v
+}
+
+func (m *yamlMapping) strings(key string) []string {
+	var res []string
+	m.sequence(key, func(n *yaml.Node, path string) error {
+		if n.Kind != yaml.ScalarNode {
+			return errorAt(path, n, "expected a string")
+		}
+		res = append(res, n.Value)
+		return nil
+	})
+	return res
+}
+
+// mapping calls f with the nested mapping at key, if it is set.
+func (m *yamlMapping) mapping(key string, f func(*yamlMapping) error) {
+	n := m.node(key)
+	if n == nil || m.err != nil {
+		return
+	}
+	m.err = decodeMapping(n, m.at(key), f)
+}
+
+// sequence calls f with each item of the sequence at key, if it is set.
+func (m *yamlMapping) sequence(key string, f func(n *yaml.Node, path string) error) {
+	n := m.node(key)
+	if n == nil || m.err != nil {
+		return
+	}
+	m.err = decodeSequence(n, m.at(key), f)
+}
+
+// done returns the first error, or an error for the first key that was not read.
+func (m *yamlMapping) done() error {
+	if m.err != nil {
+		return m.err
+	}
+	for _, k := range m.keys {
+		if !m.used[k.Value] {
+			return errorAt(m.at(k.Value), k, "unsupported key")
+		}
+	}
+	return nil
+}
+
+func decodeMapping(n *yaml.Node, path string, f func(*yamlMapping) error) error {
+	m, err := newYAMLMapping(n, path)
+	if err != nil {
+		return err
+	}
+	if err := f(m); err != nil {
+		return err
+	}
+	return m.done()
+}
+
+func decodeSequence(n *yaml.Node, path string, f func(n *yaml.Node, path string) error) error {
+	if n.Kind != yaml.SequenceNode {
+		return errorAt(path, n, "expected a list")
+	}
+	for i, item := range n.Content {
+		if err := f(item, path+"["+strconv.Itoa(i)+"]"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
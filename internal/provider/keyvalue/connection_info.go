@@ -0,0 +1,45 @@
+package keyvalue
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// GetConnectionInfo fetches the credentials for a keyvalue instance. When omit is
+// set it returns nil so that connection_info is kept out of state.
+func GetConnectionInfo(ctx context.Context, apiClient *client.ClientWithResponses, id string, omit bool) (*client.KeyValueConnectionInfo, error) {
+	if omit {
+		return nil, nil
+	}
+
+	var connectionInfo client.KeyValueConnectionInfo
+	if err := common.Get(func() (*http.Response, error) {
+		return apiClient.RetrieveKeyValueConnectionInfo(ctx, id)
+	}, &connectionInfo); err != nil {
+		return nil, err
+	}
+
+	return &connectionInfo, nil
+}
+
+// ConnectionInfoModel is the model for the keyvalue connection info ephemeral resource.
+type ConnectionInfoModel struct {
+	KeyValueID               types.String `tfsdk:"keyvalue_id"`
+	ExternalConnectionString types.String `tfsdk:"external_connection_string"`
+	InternalConnectionString types.String `tfsdk:"internal_connection_string"`
+	CLICommand               types.String `tfsdk:"cli_command"`
+}
+
+func ConnectionInfoModelFromClient(id string, c *client.KeyValueConnectionInfo) ConnectionInfoModel {
+	return ConnectionInfoModel{
+		KeyValueID:               types.StringValue(id),
+		ExternalConnectionString: types.StringValue(c.ExternalConnectionString),
+		InternalConnectionString: types.StringValue(c.InternalConnectionString),
+		CLICommand:               types.StringValue(c.CliCommand),
+	}
+}
@@ -61,6 +61,19 @@ func (d *keyvalueSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := keyvalue.FindByName(ctx, d.client, d.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find Key Value instance", err.Error())
+		return
+	}
+	plan.Id = id
+
 	var keyvalueResponse client.KeyValue
 	if err := common.Get(func() (*http.Response, error) {
 		return d.client.RetrieveKeyValue(ctx, plan.Id.ValueString())
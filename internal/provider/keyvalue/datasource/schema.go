@@ -13,12 +13,12 @@ func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Key Value instance.",
 		Attributes: map[string]schema.Attribute{
-			"id":                  datasource.ServiceID,
-			"environment_id":      datasource.ResourceEnvironmentID,
+			"id":                  datasource.LookupID("Key Value instance"),
+			"environment_id":      datasource.LookupEnvironmentID,
 			"ip_allow_list":       datasource.IPAllowList,
 			"max_memory_policy":   datasource.MaxMemoryPolicy,
 			"persistence_mode":    datasource.PersistenceMode,
-			"name":                datasource.ServiceName,
+			"name":                datasource.LookupName("Key Value instance"),
 			"plan":                datasource.KeyValuePlan,
 			"region":              datasource.Region,
 			"connection_info":     datasource.KeyValueConnectionInfo,
@@ -26,3 +26,62 @@ func Schema(ctx context.Context) schema.Schema {
 		},
 	}
 }
+
+func KeyValueInstancesDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides every Render Key Value instance in the workspace that matches the filters.",
+		MarkdownDescription: "Provides every Render Key Value instance in the workspace that matches the filters.",
+		Attributes: map[string]schema.Attribute{
+			"names":           datasource.FilterNames("Key Value instances"),
+			"environment_ids": datasource.FilterEnvironmentIDs("Key Value instances"),
+			"instances": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Key Value instances matching the filters. Use the render_keyvalue data source to read the full configuration of an instance.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the Key Value instance.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Key Value instance.",
+						},
+						"environment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment the Key Value instance belongs to. Null when it is not in an environment.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Region the instance runs in.",
+						},
+						"plan": schema.StringAttribute{
+							Computed:    true,
+							Description: "Plan of the instance.",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Version of the instance.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the instance, e.g. available or suspended.",
+						},
+						"dashboard_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the instance in the Render dashboard.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the instance was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the instance was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package ephemeral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/keyvalue"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &connectionInfoEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &connectionInfoEphemeralResource{}
+)
+
+// NewKeyValueConnectionInfoEphemeralResource is a helper function to simplify the provider implementation.
+func NewKeyValueConnectionInfoEphemeralResource() ephemeral.EphemeralResource {
+	return &connectionInfoEphemeralResource{}
+}
+
+// connectionInfoEphemeralResource is the ephemeral resource implementation.
+type connectionInfoEphemeralResource struct {
+	client *client.ClientWithResponses
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *connectionInfoEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	data := rendertypes.ConfigureEphemeralResource(req, resp)
+	if data == nil {
+		return
+	}
+
+	e.client = data.Client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *connectionInfoEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keyvalue_connection_info"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *connectionInfoEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+// Open fetches the connection info without persisting it.
+func (e *connectionInfoEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config keyvalue.ConnectionInfoModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.KeyValueID.ValueString()
+	connectionInfo, err := keyvalue.GetConnectionInfo(ctx, e.client, id, false)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to get keyvalue connection info", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, keyvalue.ConnectionInfoModelFromClient(id, connectionInfo))...)
+}
@@ -0,0 +1,36 @@
+package ephemeral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides the connection info for a Render Key Value instance without storing it in state.",
+		MarkdownDescription: "Provides the connection info for a Render Key Value instance without storing it in state or plan files.",
+		Attributes: map[string]schema.Attribute{
+			"keyvalue_id": schema.StringAttribute{
+				Description:         "Unique identifier for the Key Value instance",
+				MarkdownDescription: "Unique identifier for the Key Value instance",
+				Required:            true,
+			},
+			"external_connection_string": schema.StringAttribute{
+				Description: "Connection string for external access. Use this to connect to the key value from outside of Render.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"internal_connection_string": schema.StringAttribute{
+				Description: "Connection string for internal access. Use this to connect to the key value from within the same Render region.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"cli_command": schema.StringAttribute{
+				Description: "Command to connect to the key value using a command line tool (redis-cli or valkey-cli).",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
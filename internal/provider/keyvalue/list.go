@@ -0,0 +1,51 @@
+package keyvalue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// List lists the Key Value instances owned by owner, optionally restricted to
+// environments.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string, filter common.ListFilter, limit int64) ([]client.KeyValue, error) {
+	res, err := common.ListPages(limit, func(cursor *string, pageSize int) ([]client.KeyValueWithCursor, error) {
+		var page []client.KeyValueWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListKeyValue(ctx, &client.ListKeyValueParams{
+				OwnerId:       common.From([]string{owner}),
+				Name:          filter.Names,
+				EnvironmentId: filter.EnvironmentIDs,
+				Cursor:        cursor,
+				Limit:         &pageSize,
+			})
+		}, &page)
+		return page, err
+	}, func(kv client.KeyValueWithCursor) string { return kv.Cursor })
+	if err != nil {
+		return nil, fmt.Errorf("could not list key value instances: %w", err)
+	}
+
+	instances := make([]client.KeyValue, 0, len(res))
+	for _, kv := range res {
+		instances = append(instances, kv.KeyValue)
+	}
+	return instances, nil
+}
+
+// FindByName returns the only Key Value instance named ref.Name.
+func FindByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, ref common.NameRef) (*client.KeyValue, error) {
+	items, err := List(ctx, apiClient, owner, ref.Filter(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	kv, err := common.FindByName("Key Value instance", ref, items, func(kv client.KeyValue) (string, string) { return kv.Id, kv.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &kv, nil
+}
@@ -4,11 +4,13 @@ import (
 	"terraform-provider-render/internal/client/logs"
 	"terraform-provider-render/internal/provider/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/redis"
 )
 
 type KeyValueModel struct {
@@ -24,6 +26,13 @@ type KeyValueModel struct {
 	LogStreamOverride types.Object `tfsdk:"log_stream_override"`
 }
 
+// KeyValueResourceModel is the render_keyvalue resource state. Timeouts are
+// kept out of KeyValueModel because the data source has none.
+type KeyValueResourceModel struct {
+	KeyValueModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 var connectionInfoTypes = map[string]attr.Type{
 	"external_connection_string": types.StringType,
 	"internal_connection_string": types.StringType,
@@ -63,3 +72,75 @@ func ModelForKeyValueResult(kv *client.KeyValue, plan *KeyValueModel, connection
 		LogStreamOverride: common.LogStreamOverrideFromClient(logStreamOverride, plan.LogStreamOverride, diags),
 	}
 }
+
+// ModelFromRedisModel converts the state of a render_redis resource into the
+// state of a render_keyvalue resource. Both wrap the same API object, so only
+// the connection info differs: redis_cli_command becomes cli_command.
+func ModelFromRedisModel(r redis.RedisModel) (*KeyValueModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	connectionInfo := types.ObjectNull(connectionInfoTypes)
+	if !r.ConnectionInfo.IsNull() && !r.ConnectionInfo.IsUnknown() {
+		attrs := r.ConnectionInfo.Attributes()
+
+		var objectDiags diag.Diagnostics
+		connectionInfo, objectDiags = types.ObjectValue(
+			connectionInfoTypes,
+			map[string]attr.Value{
+				"external_connection_string": attrs["external_connection_string"],
+				"internal_connection_string": attrs["internal_connection_string"],
+				"cli_command":                attrs["redis_cli_command"],
+			},
+		)
+		diags.Append(objectDiags...)
+	}
+
+	return &KeyValueModel{
+		Id:                r.Id,
+		EnvironmentID:     r.EnvironmentID,
+		IPAllowList:       r.IPAllowList,
+		MaxMemoryPolicy:   r.MaxMemoryPolicy,
+		PersistenceMode:   r.PersistenceMode,
+		Name:              r.Name,
+		Plan:              r.Plan,
+		Region:            r.Region,
+		ConnectionInfo:    connectionInfo,
+		LogStreamOverride: r.LogStreamOverride,
+	}, diags
+}
+
+type KeyValueInstancesModel struct {
+	EnvironmentIDs types.Set               `tfsdk:"environment_ids"`
+	Names          types.Set               `tfsdk:"names"`
+	Instances      []KeyValueInstanceModel `tfsdk:"instances"`
+}
+
+// KeyValueInstanceModel summarizes a Key Value instance. Use the
+// render_keyvalue data source to read its full configuration.
+type KeyValueInstanceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Region        types.String `tfsdk:"region"`
+	Plan          types.String `tfsdk:"plan"`
+	Version       types.String `tfsdk:"version"`
+	Status        types.String `tfsdk:"status"`
+	DashboardURL  types.String `tfsdk:"dashboard_url"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+func KeyValueInstanceModelFromClient(kv client.KeyValue) KeyValueInstanceModel {
+	return KeyValueInstanceModel{
+		ID:            types.StringValue(kv.Id),
+		Name:          types.StringValue(kv.Name),
+		EnvironmentID: types.StringPointerValue(kv.EnvironmentId),
+		Region:        types.StringValue(string(kv.Region)),
+		Plan:          types.StringValue(string(kv.Plan)),
+		Version:       types.StringValue(kv.Version),
+		Status:        types.StringValue(string(kv.Status)),
+		DashboardURL:  types.StringValue(kv.DashboardUrl),
+		CreatedAt:     common.StringFromTime(kv.CreatedAt),
+		UpdatedAt:     common.StringFromTime(kv.UpdatedAt),
+	}
+}
@@ -0,0 +1,69 @@
+package keyvalue_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/provider/keyvalue"
+	"terraform-provider-render/internal/provider/redis"
+)
+
+func TestModelFromRedisModel(t *testing.T) {
+	ipAllowList := types.SetValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{
+		"cidr_block":  types.StringType,
+		"description": types.StringType,
+	}}, []attr.Value{
+		types.ObjectValueMust(map[string]attr.Type{
+			"cidr_block":  types.StringType,
+			"description": types.StringType,
+		}, map[string]attr.Value{
+			"cidr_block":  types.StringValue("10.0.0.0/8"),
+			"description": types.StringValue("office"),
+		}),
+	})
+
+	source := redis.RedisModel{
+		Id:              types.StringValue("red-123"),
+		EnvironmentID:   types.StringNull(),
+		IPAllowList:     ipAllowList,
+		MaxMemoryPolicy: types.StringValue("allkeys_lru"),
+		PersistenceMode: types.StringValue("snapshot"),
+		Name:            types.StringValue("cache"),
+		Plan:            types.StringValue("starter"),
+		Region:          types.StringValue("oregon"),
+		ConnectionInfo: types.ObjectValueMust(map[string]attr.Type{
+			"external_connection_string": types.StringType,
+			"internal_connection_string": types.StringType,
+			"redis_cli_command":          types.StringType,
+		}, map[string]attr.Value{
+			"external_connection_string": types.StringValue("rediss://external"),
+			"internal_connection_string": types.StringValue("redis://internal"),
+			"redis_cli_command":          types.StringValue("redis-cli -u rediss://external"),
+		}),
+		LogStreamOverride: types.ObjectNull(map[string]attr.Type{}),
+	}
+
+	t.Run("it maps the redis state", func(t *testing.T) {
+		target, diags := keyvalue.ModelFromRedisModel(source)
+		require.False(t, diags.HasError())
+
+		assert.Equal(t, "red-123", target.Id.ValueString())
+		assert.Equal(t, "allkeys_lru", target.MaxMemoryPolicy.ValueString())
+		assert.True(t, target.IPAllowList.Equal(ipAllowList))
+		assert.Equal(t, types.StringValue("redis-cli -u rediss://external"), target.ConnectionInfo.Attributes()["cli_command"])
+		assert.NotContains(t, target.ConnectionInfo.Attributes(), "redis_cli_command")
+	})
+
+	t.Run("it keeps omitted connection info null", func(t *testing.T) {
+		withoutConnectionInfo := source
+		withoutConnectionInfo.ConnectionInfo = types.ObjectNull(map[string]attr.Type{})
+
+		target, diags := keyvalue.ModelFromRedisModel(withoutConnectionInfo)
+		require.False(t, diags.HasError())
+		assert.True(t, target.ConnectionInfo.IsNull())
+	})
+}
@@ -0,0 +1,45 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/keyvalue"
+	"terraform-provider-render/internal/provider/types/listresource"
+)
+
+var _ list.ListResourceWithConfigure = &keyvalueResource{}
+
+// NewKeyValueListResource is a helper function to simplify the provider implementation.
+func NewKeyValueListResource() list.ListResource {
+	return &keyvalueResource{}
+}
+
+func (r *keyvalueResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listresource.Schema("Lists the Key Value instances in a Render workspace.", true)
+}
+
+// List streams the Key Value instances owned by the workspace.
+func (r *keyvalueResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ownerID, diags := common.ListOwnerID(ctx, req.Config, r.ownerID)
+	environmentIDs, envDiags := common.ListEnvironmentIDs(ctx, req.Config)
+	diags.Append(envDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := keyvalue.List(ctx, r.client, ownerID, common.ListFilter{EnvironmentIDs: environmentIDs}, req.Limit)
+	if err != nil {
+		diags.AddError("Unable to list Key Value instances", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, r, ownerID, items, func(kv client.KeyValue) (string, string) {
+		return kv.Id, kv.Name
+	})
+}
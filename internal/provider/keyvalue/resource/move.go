@@ -0,0 +1,47 @@
+package resource
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/keyvalue"
+	"terraform-provider-render/internal/provider/redis"
+	redisresource "terraform-provider-render/internal/provider/redis/resource"
+)
+
+var _ resource.ResourceWithMoveState = &keyvalueResource{}
+
+// MoveState allows a moved block to migrate a render_redis resource to
+// render_keyvalue without removing and reimporting it.
+func (r *keyvalueResource) MoveState(ctx context.Context) []resource.StateMover {
+	sourceSchema := redisresource.Schema(ctx)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "render_redis" || !strings.HasSuffix(req.SourceProviderAddress, "/render") {
+					return
+				}
+
+				var source redis.RedisResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				target, diags := keyvalue.ModelFromRedisModel(source.RedisModel)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, keyvalue.KeyValueResourceModel{KeyValueModel: *target, Timeouts: source.Timeouts})...)
+				resp.Diagnostics.Append(common.SetIdentity(ctx, resp.TargetState, resp.TargetIdentity, r.ownerID)...)
+			},
+		},
+	}
+}
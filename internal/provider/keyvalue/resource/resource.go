@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -14,6 +13,7 @@ import (
 	"terraform-provider-render/internal/client"
 	"terraform-provider-render/internal/provider/keyvalue/resource/internal"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                     = &keyvalueResource{}
 	_ resource.ResourceWithConfigure        = &keyvalueResource{}
 	_ resource.ResourceWithImportState      = &keyvalueResource{}
+	_ resource.ResourceWithIdentity         = &keyvalueResource{}
 	_ resource.ResourceWithConfigValidators = &keyvalueResource{}
 )
 
@@ -31,8 +32,9 @@ func NewKeyValueResource() resource.Resource {
 
 // keyvalueResource is the resource implementation.
 type keyvalueResource struct {
-	client  *client.ClientWithResponses
-	ownerID string
+	client             *client.ClientWithResponses
+	ownerID            string
+	omitConnectionInfo bool
 }
 
 // Configure adds the provider configured Client to the resource.
@@ -44,6 +46,7 @@ func (r *keyvalueResource) Configure(_ context.Context, req resource.ConfigureRe
 
 	r.client = data.Client
 	r.ownerID = data.OwnerID
+	r.omitConnectionInfo = data.OmitConnectionInfoFromState
 }
 
 // Metadata returns the resource type name.
@@ -56,16 +59,29 @@ func (r *keyvalueResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 	resp.Schema = Schema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *keyvalueResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *keyvalueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan keyvalue.KeyValueModel
+	var plan keyvalue.KeyValueResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.CreateKeyValueRequestFromModel(r.ownerID, plan)
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultDatastoreTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	serviceDetails, err := internal.CreateKeyValueRequestFromModel(r.ownerID, plan.KeyValueModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating service", "Could not create service, unexpected error: "+err.Error(),
@@ -75,17 +91,15 @@ func (r *keyvalueResource) Create(ctx context.Context, req resource.CreateReques
 
 	var model client.KeyValue
 	err = common.Create(func() (*http.Response, error) {
-		return r.client.CreateKeyValue(ctx, serviceDetails)
+		return r.client.CreateKeyValue(createCtx, serviceDetails)
 	}, &model)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating service", err.Error())
 		return
 	}
 
-	var connectionInfo client.KeyValueConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrieveKeyValueConnectionInfo(ctx, model.Id)
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := keyvalue.GetConnectionInfo(ctx, r.client, model.Id, r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get keyvalue connection info", err.Error())
 		return
 	}
@@ -102,16 +116,17 @@ func (r *keyvalueResource) Create(ctx context.Context, req resource.CreateReques
 		resp.Diagnostics.AddError("unable to create log stream overrides", err.Error())
 		return
 	}
-	keyvalueModel := keyvalue.ModelForKeyValueResult(&model, &plan, &connectionInfo, logStreamOverrides, resp.Diagnostics)
+	keyvalueModel := keyvalue.ModelForKeyValueResult(&model, &plan.KeyValueModel, connectionInfo, logStreamOverrides, resp.Diagnostics)
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, keyvalueModel)
+	diags = resp.State.Set(ctx, keyvalue.KeyValueResourceModel{KeyValueModel: *keyvalueModel, Timeouts: plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 // Read resource information.
 func (r *keyvalueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state keyvalue.KeyValueModel
+	var state keyvalue.KeyValueResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -134,10 +149,8 @@ func (r *keyvalueResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	var connectionInfo client.KeyValueConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrieveKeyValueConnectionInfo(ctx, clientKeyValue.Id)
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := keyvalue.GetConnectionInfo(ctx, r.client, clientKeyValue.Id, r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get keyvalue connection info", err.Error())
 		return
 	}
@@ -148,29 +161,38 @@ func (r *keyvalueResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	keyvalueModel := keyvalue.ModelForKeyValueResult(&clientKeyValue, &state, &connectionInfo, logStreamOverrides, resp.Diagnostics)
+	keyvalueModel := keyvalue.ModelForKeyValueResult(&clientKeyValue, &state.KeyValueModel, connectionInfo, logStreamOverrides, resp.Diagnostics)
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, keyvalueModel)
+	diags = resp.State.Set(ctx, keyvalue.KeyValueResourceModel{KeyValueModel: *keyvalueModel, Timeouts: state.Timeouts})
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *keyvalueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan keyvalue.KeyValueModel
+	var plan keyvalue.KeyValueResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state keyvalue.KeyValueModel
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultDatastoreTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state keyvalue.KeyValueResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.UpdateServiceRequestFromModel(plan)
+	serviceDetails, err := internal.UpdateServiceRequestFromModel(plan.KeyValueModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service", "Could not update service, unexpected error: "+err.Error(),
@@ -189,10 +211,8 @@ func (r *keyvalueResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	var connectionInfo client.KeyValueConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrieveKeyValueConnectionInfo(ctx, keyvalueResponse.Id)
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := keyvalue.GetConnectionInfo(ctx, r.client, keyvalueResponse.Id, r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get keyvalue connection info", err.Error())
 		return
 	}
@@ -211,7 +231,7 @@ func (r *keyvalueResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	keyvalueModel := keyvalue.ModelForKeyValueResult(&keyvalueResponse, &plan, &connectionInfo, logStreamOverrides, resp.Diagnostics)
+	keyvalueModel := keyvalue.ModelForKeyValueResult(&keyvalueResponse, &plan.KeyValueModel, connectionInfo, logStreamOverrides, resp.Diagnostics)
 
 	envID, err := common.UpdateEnvironmentID(ctx, r.client, keyvalueModel.Id.ValueString(), &common.EnvironmentIDStateAndPlan{
 		State: state.EnvironmentID.ValueStringPointer(),
@@ -226,21 +246,30 @@ func (r *keyvalueResource) Update(ctx context.Context, req resource.UpdateReques
 
 	keyvalueModel.EnvironmentID = types.StringPointerValue(envID)
 
-	diags = resp.State.Set(ctx, keyvalueModel)
+	diags = resp.State.Set(ctx, keyvalue.KeyValueResourceModel{KeyValueModel: *keyvalueModel, Timeouts: plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *keyvalueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state keyvalue.KeyValueModel
+	var state keyvalue.KeyValueResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := common.Delete(func() (*http.Response, error) {
 		return r.client.DeleteKeyValue(ctx, state.Id.ValueString())
 	})
@@ -254,8 +283,15 @@ func (r *keyvalueResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *keyvalueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is either the resource ID or a name reference such as
+	// name:my-api or env:<environment_id>/name:my-api.
+	common.ImportStateByName(ctx, req, resp, func(ref common.NameRef) (string, error) {
+		found, err := keyvalue.FindByName(ctx, r.client, r.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
 }
 
 func (r *keyvalueResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -0,0 +1,105 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/logs"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+const defaultLimit = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &logsDataSource{}
+	_ datasource.DataSourceWithConfigure = &logsDataSource{}
+)
+
+// NewLogsDataSource is a helper function to simplify the provider implementation.
+func NewLogsDataSource() datasource.DataSource {
+	return &logsDataSource{}
+}
+
+// logsDataSource is the data source implementation.
+type logsDataSource struct {
+	client  *client.ClientWithResponses
+	ownerID string
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *logsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := rendertypes.ConfigureDatasource(req, resp)
+	if data == nil {
+		return
+	}
+
+	d.client = data.Client
+	d.ownerID = data.OwnerID
+}
+
+// Metadata returns the data source type name.
+func (d *logsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *logsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *logsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config logs.LogsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime, err := common.TimeFromString(config.StartTime)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid start time", err.Error())
+	}
+
+	endTime, err := common.TimeFromString(config.EndTime)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid end time", err.Error())
+	}
+
+	params := logs.ListParams{
+		OwnerID:   d.ownerID,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Limit:     defaultLimit,
+	}
+	for _, id := range config.ResourceIDs {
+		params.ResourceIDs = append(params.ResourceIDs, id.ValueString())
+	}
+	if !config.Limit.IsNull() {
+		params.Limit = int(config.Limit.ValueInt64())
+	}
+
+	resp.Diagnostics.Append(config.Levels.ElementsAs(ctx, &params.Levels, false)...)
+	resp.Diagnostics.Append(config.Text.ElementsAs(ctx, &params.Text, false)...)
+	resp.Diagnostics.Append(config.InstanceIDs.ElementsAs(ctx, &params.InstanceIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := logs.ListLogs(ctx, d.client, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list logs", err.Error())
+		return
+	}
+
+	config.Entries = make([]logs.EntryModel, 0, len(entries))
+	for _, entry := range entries {
+		config.Entries = append(config.Entries, logs.EntryModelFromClient(entry))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,105 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/provider/common/validators"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Provides the log entries of Render services and datastores within a time window.",
+		Attributes: map[string]schema.Attribute{
+			"resource_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Unique identifiers of the services, postgres or key value instances to read logs for.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(validators.StringNotEmpty),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start of the time window as an RFC 3339 timestamp. Defaults to one hour before end_time.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"end_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the time window as an RFC 3339 timestamp. Defaults to now.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"levels": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return entries with one of these log levels, e.g. error or warning.",
+				Validators:  []validator.Set{setvalidator.ValueStringsAre(validators.StringNotEmpty)},
+			},
+			"text": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return entries whose message matches one of these strings. Wildcards (*) and regular expressions in slashes (/panic:.*/) are supported.",
+				Validators:  []validator.Set{setvalidator.ValueStringsAre(validators.StringNotEmpty)},
+			},
+			"instance_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return entries logged by these instances.",
+				Validators:  []validator.Set{setvalidator.ValueStringsAre(validators.StringNotEmpty)},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of entries to return. Defaults to 100.",
+				Validators:  []validator.Int64{int64validator.Between(1, 10000)},
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Log entries in the time window, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the log entry.",
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the entry was logged.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Message of the log entry.",
+						},
+						"level": schema.StringAttribute{
+							Computed:    true,
+							Description: "Log level of the entry, if one was detected.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the entry, e.g. app, request or build.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Resource that logged the entry.",
+						},
+						"instance_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Instance that logged the entry.",
+						},
+						"labels": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "All labels of the entry, such as host, method, path and statusCode for request logs.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
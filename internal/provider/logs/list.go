@@ -0,0 +1,75 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"terraform-provider-render/internal/client"
+	clientlogs "terraform-provider-render/internal/client/logs"
+	"terraform-provider-render/internal/provider/common"
+)
+
+const pageSize = 100
+
+type ListParams struct {
+	OwnerID     string
+	ResourceIDs []string
+	StartTime   *time.Time
+	EndTime     *time.Time
+	Levels      []string
+	Text        []string
+	InstanceIDs []string
+	Limit       int
+}
+
+// ListLogs returns up to params.Limit log entries in the time window, newest
+// first. The API pages backwards in time, handing back the window to use for
+// the next request.
+func ListLogs(ctx context.Context, apiClient *client.ClientWithResponses, params ListParams) ([]clientlogs.Log, error) {
+	direction := clientlogs.Backward
+	startTime, endTime := params.StartTime, params.EndTime
+
+	var res []clientlogs.Log
+	for params.Limit <= 0 || len(res) < params.Limit {
+		limit := pageSize
+		if params.Limit > 0 && params.Limit-len(res) < limit {
+			limit = params.Limit - len(res)
+		}
+
+		var page client.Logs200Response
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListLogs(ctx, &client.ListLogsParams{
+				OwnerId:   params.OwnerID,
+				Resource:  params.ResourceIDs,
+				StartTime: startTime,
+				EndTime:   endTime,
+				Direction: &direction,
+				Level:     nonEmpty(params.Levels),
+				Text:      nonEmpty(params.Text),
+				Instance:  nonEmpty(params.InstanceIDs),
+				Limit:     &limit,
+			})
+		}, &page)
+		if err != nil {
+			return nil, fmt.Errorf("could not list logs: %w", err)
+		}
+
+		res = append(res, page.Logs...)
+
+		if !page.HasMore || len(page.Logs) == 0 {
+			break
+		}
+		startTime, endTime = &page.NextStartTime, &page.NextEndTime
+	}
+
+	return res, nil
+}
+
+func nonEmpty(values []string) *[]string {
+	if len(values) == 0 {
+		return nil
+	}
+	return &values
+}
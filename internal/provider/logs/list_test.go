@@ -0,0 +1,105 @@
+package logs_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/logs"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func TestListLogs(t *testing.T) {
+	t.Run("it follows the next window until there are no more logs", func(t *testing.T) {
+		var endTimes []string
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/logs": func(resp http.ResponseWriter, req *http.Request) {
+				query := req.URL.Query()
+				assert.Equal(t, "own-123", query.Get("ownerId"))
+				assert.Equal(t, []string{"srv-1", "srv-2"}, query["resource"])
+				assert.Equal(t, []string{"error"}, query["level"])
+				assert.Equal(t, []string{"panic"}, query["text"])
+				endTimes = append(endTimes, query.Get("endTime"))
+
+				if query.Get("endTime") == "" {
+					th.StaticResponse(`{
+						"hasMore": true,
+						"nextStartTime": "2024-01-01T09:00:00Z",
+						"nextEndTime": "2024-01-01T10:00:00Z",
+						"logs": [{"id": "log-1", "timestamp": "2024-01-01T10:30:00Z", "message": "panic: oh no", "labels": [
+							{"name": "resource", "value": "srv-1"},
+							{"name": "instance", "value": "srv-1-abcde"},
+							{"name": "level", "value": "error"}
+						]}]
+					}`)(resp, req)
+					return
+				}
+
+				th.StaticResponse(`{
+					"hasMore": false,
+					"nextStartTime": "2024-01-01T08:00:00Z",
+					"nextEndTime": "2024-01-01T09:00:00Z",
+					"logs": [{"id": "log-2", "timestamp": "2024-01-01T09:30:00Z", "message": "panic: again", "labels": []}]
+				}`)(resp, req)
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		entries, err := logs.ListLogs(context.Background(), c, logs.ListParams{
+			OwnerID:     "own-123",
+			ResourceIDs: []string{"srv-1", "srv-2"},
+			Levels:      []string{"error"},
+			Text:        []string{"panic"},
+			Limit:       10,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"", "2024-01-01T10:00:00Z"}, endTimes)
+		require.Len(t, entries, 2)
+
+		first := logs.EntryModelFromClient(entries[0])
+		assert.Equal(t, "log-1", first.ID.ValueString())
+		assert.Equal(t, "srv-1", first.ResourceID.ValueString())
+		assert.Equal(t, "srv-1-abcde", first.InstanceID.ValueString())
+		assert.Equal(t, "error", first.Level.ValueString())
+		assert.True(t, first.Type.IsNull())
+
+		second := logs.EntryModelFromClient(entries[1])
+		assert.Equal(t, "log-2", second.ID.ValueString())
+		assert.True(t, second.Level.IsNull())
+	})
+
+	t.Run("it stops at the limit", func(t *testing.T) {
+		var limits []string
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/logs": func(resp http.ResponseWriter, req *http.Request) {
+				limits = append(limits, req.URL.Query().Get("limit"))
+				th.StaticResponse(`{
+					"hasMore": true,
+					"nextStartTime": "2024-01-01T08:00:00Z",
+					"nextEndTime": "2024-01-01T09:00:00Z",
+					"logs": [{"id": "log-1", "timestamp": "2024-01-01T09:30:00Z", "message": "hi", "labels": []}]
+				}`)(resp, req)
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		entries, err := logs.ListLogs(context.Background(), c, logs.ListParams{
+			OwnerID:     "own-123",
+			ResourceIDs: []string{"srv-1"},
+			Limit:       2,
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, entries, 2)
+		assert.Equal(t, []string{"2", "1"}, limits)
+	})
+}
@@ -0,0 +1,55 @@
+package logs
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	clientlogs "terraform-provider-render/internal/client/logs"
+	"terraform-provider-render/internal/provider/common"
+)
+
+type LogsModel struct {
+	ResourceIDs []types.String `tfsdk:"resource_ids"`
+	StartTime   types.String   `tfsdk:"start_time"`
+	EndTime     types.String   `tfsdk:"end_time"`
+	Levels      types.Set      `tfsdk:"levels"`
+	Text        types.Set      `tfsdk:"text"`
+	InstanceIDs types.Set      `tfsdk:"instance_ids"`
+	Limit       types.Int64    `tfsdk:"limit"`
+	Entries     []EntryModel   `tfsdk:"entries"`
+}
+
+type EntryModel struct {
+	ID         types.String            `tfsdk:"id"`
+	Timestamp  types.String            `tfsdk:"timestamp"`
+	Message    types.String            `tfsdk:"message"`
+	Level      types.String            `tfsdk:"level"`
+	Type       types.String            `tfsdk:"type"`
+	ResourceID types.String            `tfsdk:"resource_id"`
+	InstanceID types.String            `tfsdk:"instance_id"`
+	Labels     map[string]types.String `tfsdk:"labels"`
+}
+
+func EntryModelFromClient(log clientlogs.Log) EntryModel {
+	labels := make(map[string]types.String, len(log.Labels))
+	for _, l := range log.Labels {
+		labels[string(l.Name)] = types.StringValue(l.Value)
+	}
+
+	label := func(name clientlogs.LogLabelName) types.String {
+		if v, ok := labels[string(name)]; ok {
+			return v
+		}
+		return types.StringNull()
+	}
+
+	return EntryModel{
+		ID:         types.StringValue(log.Id),
+		Timestamp:  common.StringFromTime(log.Timestamp),
+		Message:    types.StringValue(log.Message),
+		Level:      label(clientlogs.LogLabelNameLevel),
+		Type:       label(clientlogs.LogLabelNameType),
+		ResourceID: label(clientlogs.LogLabelNameResource),
+		InstanceID: label(clientlogs.LogLabelNameInstance),
+		Labels:     labels,
+	}
+}
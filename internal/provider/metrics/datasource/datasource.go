@@ -0,0 +1,105 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/metrics"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &metricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &metricsDataSource{}
+)
+
+// NewMetricsDataSource is a helper function to simplify the provider implementation.
+func NewMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{}
+}
+
+// metricsDataSource is the data source implementation.
+type metricsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *metricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := rendertypes.ConfigureDatasource(req, resp)
+	if data == nil {
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Metadata returns the data source type name.
+func (d *metricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics"
+}
+
+// Schema defines the schema for the data source.
+func (d *metricsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *metricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config metrics.MetricsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime, err := common.TimeFromString(config.StartTime)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid start time", err.Error())
+	}
+
+	endTime, err := common.TimeFromString(config.EndTime)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid end time", err.Error())
+	}
+
+	query := metrics.Query{
+		Metric:      config.Metric.ValueString(),
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Aggregation: config.Aggregation.ValueStringPointer(),
+		InstanceID:  config.InstanceID.ValueStringPointer(),
+		Host:        config.Host.ValueStringPointer(),
+		Path:        config.Path.ValueStringPointer(),
+		AggregateBy: config.AggregateBy.ValueStringPointer(),
+	}
+	for _, id := range config.ResourceIDs {
+		query.ResourceIDs = append(query.ResourceIDs, id.ValueString())
+	}
+	if !config.ResolutionSeconds.IsNull() {
+		query.ResolutionSeconds = common.From(float32(config.ResolutionSeconds.ValueInt64()))
+	}
+	if !config.Quantile.IsNull() {
+		query.Quantile = common.From(float32(config.Quantile.ValueFloat64()))
+	}
+
+	if err := query.Validate(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metric"), "Unsupported metric option", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	series, err := metrics.GetMetrics(ctx, d.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get metrics", err.Error())
+		return
+	}
+
+	config.Series = metrics.SeriesFromClient(series)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
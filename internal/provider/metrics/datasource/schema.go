@@ -0,0 +1,125 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/provider/common/validators"
+	"terraform-provider-render/internal/provider/metrics"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Provides time series metrics for Render services and datastores, such as CPU, memory and HTTP latency, within a time window.",
+		Attributes: map[string]schema.Attribute{
+			"resource_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Unique identifiers of the services, postgres or key value instances to read metrics for.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(validators.StringNotEmpty),
+				},
+			},
+			"metric": schema.StringAttribute{
+				Required:    true,
+				Description: "Metric to read. One of cpu, memory, http_latency, http_requests, instance_count, bandwidth, disk_usage, replication_lag or active_connections.",
+				Validators:  []validator.String{stringvalidator.OneOf(metrics.Metrics...)},
+			},
+			"start_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start of the time window as an RFC 3339 timestamp. Defaults to one hour before end_time.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"end_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the time window as an RFC 3339 timestamp. Defaults to now.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"resolution_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Seconds between data points. Defaults to 60. Not supported for bandwidth.",
+				Validators:  []validator.Int64{int64validator.AtLeast(30)},
+			},
+			"aggregation": schema.StringAttribute{
+				Optional:    true,
+				Description: "How cpu values are aggregated across instances. One of AVG, MIN or MAX.",
+				Validators:  []validator.String{stringvalidator.OneOf("AVG", "MIN", "MAX")},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return cpu or memory metrics for this instance.",
+			},
+			"host": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return http_latency or http_requests metrics for this host.",
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return http_latency or http_requests metrics for this request path.",
+			},
+			"quantile": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Quantile of http_latency to return, e.g. 0.95. Defaults to 0.95.",
+				Validators:  []validator.Float64{float64validator.Between(0, 1)},
+			},
+			"aggregate_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "Split http_requests into one series per host or per status code. One of host or statusCode.",
+				Validators:  []validator.String{stringvalidator.OneOf("host", "statusCode")},
+			},
+			"series": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Time series returned for the query.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"labels": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Labels identifying the series, such as resource, instance or statusCode.",
+						},
+						"unit": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unit of the values in the series.",
+						},
+						"points": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Data points of the series, oldest first.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"timestamp": schema.StringAttribute{
+										Computed:    true,
+										Description: "Time of the data point.",
+									},
+									"value": schema.Float64Attribute{
+										Computed:    true,
+										Description: "Value of the data point.",
+									},
+								},
+							},
+						},
+						"min": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Lowest value in the series. Null when the series has no points.",
+						},
+						"max": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Highest value in the series. Null when the series has no points.",
+						},
+						"average": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Mean of the values in the series. Null when the series has no points.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
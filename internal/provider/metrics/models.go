@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	clientmetrics "terraform-provider-render/internal/client/metrics"
+	"terraform-provider-render/internal/provider/common"
+)
+
+type MetricsModel struct {
+	ResourceIDs       []types.String `tfsdk:"resource_ids"`
+	Metric            types.String   `tfsdk:"metric"`
+	StartTime         types.String   `tfsdk:"start_time"`
+	EndTime           types.String   `tfsdk:"end_time"`
+	ResolutionSeconds types.Int64    `tfsdk:"resolution_seconds"`
+	Aggregation       types.String   `tfsdk:"aggregation"`
+	InstanceID        types.String   `tfsdk:"instance_id"`
+	Host              types.String   `tfsdk:"host"`
+	Path              types.String   `tfsdk:"path"`
+	Quantile          types.Float64  `tfsdk:"quantile"`
+	AggregateBy       types.String   `tfsdk:"aggregate_by"`
+	Series            []SeriesModel  `tfsdk:"series"`
+}
+
+type SeriesModel struct {
+	Labels  map[string]types.String `tfsdk:"labels"`
+	Unit    types.String            `tfsdk:"unit"`
+	Points  []PointModel            `tfsdk:"points"`
+	Min     types.Float64           `tfsdk:"min"`
+	Max     types.Float64           `tfsdk:"max"`
+	Average types.Float64           `tfsdk:"average"`
+}
+
+type PointModel struct {
+	Timestamp types.String  `tfsdk:"timestamp"`
+	Value     types.Float64 `tfsdk:"value"`
+}
+
+func SeriesFromClient(series clientmetrics.TimeSeriesCollection) []SeriesModel {
+	res := make([]SeriesModel, 0, len(series))
+	for _, s := range series {
+		labels := make(map[string]types.String, len(s.Labels))
+		for _, l := range s.Labels {
+			labels[l.Field] = types.StringValue(l.Value)
+		}
+
+		values := make([]clientmetrics.TimeSeriesValue, len(s.Values))
+		copy(values, s.Values)
+		sort.SliceStable(values, func(i, j int) bool {
+			return values[i].Timestamp.Before(values[j].Timestamp)
+		})
+
+		model := SeriesModel{
+			Labels:  labels,
+			Unit:    types.StringValue(s.Unit),
+			Points:  make([]PointModel, 0, len(values)),
+			Min:     types.Float64Null(),
+			Max:     types.Float64Null(),
+			Average: types.Float64Null(),
+		}
+
+		var sum, lowest, highest float64
+		for i, v := range values {
+			value := float64(v.Value)
+			model.Points = append(model.Points, PointModel{
+				Timestamp: common.StringFromTime(v.Timestamp),
+				Value:     types.Float64Value(value),
+			})
+
+			sum += value
+			if i == 0 || value < lowest {
+				lowest = value
+			}
+			if i == 0 || value > highest {
+				highest = value
+			}
+		}
+
+		if len(values) > 0 {
+			model.Min = types.Float64Value(lowest)
+			model.Max = types.Float64Value(highest)
+			model.Average = types.Float64Value(sum / float64(len(values)))
+		}
+
+		res = append(res, model)
+	}
+
+	return res
+}
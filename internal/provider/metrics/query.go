@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"terraform-provider-render/internal/client"
+	clientmetrics "terraform-provider-render/internal/client/metrics"
+	"terraform-provider-render/internal/provider/common"
+)
+
+const (
+	MetricCPU               = "cpu"
+	MetricMemory            = "memory"
+	MetricHTTPLatency       = "http_latency"
+	MetricHTTPRequests      = "http_requests"
+	MetricInstanceCount     = "instance_count"
+	MetricBandwidth         = "bandwidth"
+	MetricDiskUsage         = "disk_usage"
+	MetricReplicationLag    = "replication_lag"
+	MetricActiveConnections = "active_connections"
+)
+
+var Metrics = []string{
+	MetricCPU,
+	MetricMemory,
+	MetricHTTPLatency,
+	MetricHTTPRequests,
+	MetricInstanceCount,
+	MetricBandwidth,
+	MetricDiskUsage,
+	MetricReplicationLag,
+	MetricActiveConnections,
+}
+
+type Query struct {
+	Metric            string
+	ResourceIDs       []string
+	StartTime         *time.Time
+	EndTime           *time.Time
+	ResolutionSeconds *float32
+	Aggregation       *string
+	InstanceID        *string
+	Host              *string
+	Path              *string
+	Quantile          *float32
+	AggregateBy       *string
+}
+
+// Validate reports options that the metric's endpoint does not accept.
+func (q Query) Validate() error {
+	switch {
+	case q.Aggregation != nil && q.Metric != MetricCPU:
+		return fmt.Errorf("aggregation is only supported for the %s metric", MetricCPU)
+	case q.InstanceID != nil && q.Metric != MetricCPU && q.Metric != MetricMemory:
+		return fmt.Errorf("instance_id is only supported for the %s and %s metrics", MetricCPU, MetricMemory)
+	case (q.Host != nil || q.Path != nil) && q.Metric != MetricHTTPLatency && q.Metric != MetricHTTPRequests:
+		return fmt.Errorf("host and path are only supported for the %s and %s metrics", MetricHTTPLatency, MetricHTTPRequests)
+	case q.Quantile != nil && q.Metric != MetricHTTPLatency:
+		return fmt.Errorf("quantile is only supported for the %s metric", MetricHTTPLatency)
+	case q.AggregateBy != nil && q.Metric != MetricHTTPRequests:
+		return fmt.Errorf("aggregate_by is only supported for the %s metric", MetricHTTPRequests)
+	case q.ResolutionSeconds != nil && q.Metric == MetricBandwidth:
+		return fmt.Errorf("resolution_seconds is not supported for the %s metric", MetricBandwidth)
+	}
+	return nil
+}
+
+// GetMetrics fetches the time series for a query.
+func GetMetrics(ctx context.Context, apiClient *client.ClientWithResponses, q Query) (clientmetrics.TimeSeriesCollection, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	if len(q.ResourceIDs) == 0 {
+		return nil, fmt.Errorf("at least one resource ID is required")
+	}
+
+	// The generated params only hold a single resource, but the API ORs together
+	// repeated resource params, so the full list is written onto the request.
+	resource := q.ResourceIDs[0]
+	withResources := func(_ context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		query.Del("resource")
+		for _, id := range q.ResourceIDs {
+			query.Add("resource", id)
+		}
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+
+	var res clientmetrics.TimeSeriesCollection
+	err := common.Get(func() (*http.Response, error) {
+		switch q.Metric {
+		case MetricCPU:
+			return apiClient.GetCpu(ctx, &client.GetCpuParams{
+				StartTime:         q.StartTime,
+				EndTime:           q.EndTime,
+				ResolutionSeconds: q.ResolutionSeconds,
+				Resource:          &resource,
+				Instance:          q.InstanceID,
+				AggregationMethod: (*clientmetrics.ApplicationMetricAggregationMethod)(q.Aggregation),
+			}, withResources)
+		case MetricMemory:
+			return apiClient.GetMemory(ctx, &client.GetMemoryParams{
+				StartTime:         q.StartTime,
+				EndTime:           q.EndTime,
+				ResolutionSeconds: q.ResolutionSeconds,
+				Resource:          &resource,
+				Instance:          q.InstanceID,
+			}, withResources)
+		case MetricHTTPLatency:
+			return apiClient.GetHttpLatency(ctx, &client.GetHttpLatencyParams{
+				StartTime:         q.StartTime,
+				EndTime:           q.EndTime,
+				ResolutionSeconds: q.ResolutionSeconds,
+				Resource:          &resource,
+				Host:              q.Host,
+				Path:              q.Path,
+				Quantile:          q.Quantile,
+			}, withResources)
+		case MetricHTTPRequests:
+			return apiClient.GetHttpRequests(ctx, &client.GetHttpRequestsParams{
+				StartTime:         q.StartTime,
+				EndTime:           q.EndTime,
+				ResolutionSeconds: q.ResolutionSeconds,
+				Resource:          &resource,
+				Host:              q.Host,
+				Path:              q.Path,
+				AggregateBy:       (*clientmetrics.HttpAggregateBy)(q.AggregateBy),
+			}, withResources)
+		case MetricInstanceCount:
+			return apiClient.GetInstanceCount(ctx, &client.GetInstanceCountParams{
+				StartTime:         q.StartTime,
+				EndTime:           q.EndTime,
+				ResolutionSeconds: q.ResolutionSeconds,
+				Resource:          &resource,
+			}, withResources)
+		case MetricBandwidth:
+			return apiClient.GetBandwidth(ctx, &client.GetBandwidthParams{
+				StartTime: q.StartTime,
+				EndTime:   q.EndTime,
+				Resource:  &resource,
+			}, withResources)
+		case MetricDiskUsage:
+			return apiClient.GetDiskUsage(ctx, &client.GetDiskUsageParams{
+				StartTime:         q.StartTime,
+				EndTime:           q.EndTime,
+				ResolutionSeconds: q.ResolutionSeconds,
+				Resource:          &resource,
+			}, withResources)
+		case MetricReplicationLag:
+			return apiClient.GetReplicationLag(ctx, &client.GetReplicationLagParams{
+				StartTime:         q.StartTime,
+				EndTime:           q.EndTime,
+				ResolutionSeconds: q.ResolutionSeconds,
+				Resource:          &resource,
+			}, withResources)
+		case MetricActiveConnections:
+			return apiClient.GetActiveConnections(ctx, &client.GetActiveConnectionsParams{
+				StartTime:         q.StartTime,
+				EndTime:           q.EndTime,
+				ResolutionSeconds: q.ResolutionSeconds,
+				Resource:          &resource,
+			}, withResources)
+		}
+		return nil, fmt.Errorf("unsupported metric %q", q.Metric)
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s metrics: %w", q.Metric, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/metrics"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func TestGetMetrics(t *testing.T) {
+	t.Run("it sends every resource and summarizes the series", func(t *testing.T) {
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/metrics/http-latency": func(resp http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, []string{"srv-1", "srv-2"}, req.URL.Query()["resource"])
+				assert.Equal(t, "0.99", req.URL.Query().Get("quantile"))
+				assert.Equal(t, "/api", req.URL.Query().Get("path"))
+
+				th.StaticResponse(`[{
+					"labels": [{"field": "resource", "value": "srv-1"}],
+					"unit": "ms",
+					"values": [
+						{"timestamp": "2024-01-01T10:02:00Z", "value": 300},
+						{"timestamp": "2024-01-01T10:00:00Z", "value": 100},
+						{"timestamp": "2024-01-01T10:01:00Z", "value": 200}
+					]
+				}, {
+					"labels": [{"field": "resource", "value": "srv-2"}],
+					"unit": "ms",
+					"values": []
+				}]`)(resp, req)
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		res, err := metrics.GetMetrics(context.Background(), c, metrics.Query{
+			Metric:      metrics.MetricHTTPLatency,
+			ResourceIDs: []string{"srv-1", "srv-2"},
+			Quantile:    common.From(float32(0.99)),
+			Path:        common.From("/api"),
+		})
+		require.NoError(t, err)
+
+		series := metrics.SeriesFromClient(res)
+		require.Len(t, series, 2)
+
+		assert.Equal(t, "srv-1", series[0].Labels["resource"].ValueString())
+		assert.Equal(t, "ms", series[0].Unit.ValueString())
+		require.Len(t, series[0].Points, 3)
+		assert.Equal(t, "2024-01-01T10:00:00Z", series[0].Points[0].Timestamp.ValueString())
+		assert.Equal(t, float64(100), series[0].Min.ValueFloat64())
+		assert.Equal(t, float64(300), series[0].Max.ValueFloat64())
+		assert.Equal(t, float64(200), series[0].Average.ValueFloat64())
+
+		assert.Empty(t, series[1].Points)
+		assert.True(t, series[1].Average.IsNull())
+	})
+
+	t.Run("it rejects options the metric does not support", func(t *testing.T) {
+		_, err := metrics.GetMetrics(context.Background(), nil, metrics.Query{
+			Metric:      metrics.MetricMemory,
+			ResourceIDs: []string{"srv-1"},
+			Quantile:    common.From(float32(0.5)),
+		})
+		assert.ErrorContains(t, err, "quantile is only supported for the http_latency metric")
+	})
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// GetConnectionInfo fetches the credentials for a postgres instance. When omit is
+// set it returns nil so that connection_info is kept out of state.
+func GetConnectionInfo(ctx context.Context, apiClient *client.ClientWithResponses, id string, omit bool) (*client.PostgresConnectionInfo, error) {
+	if omit {
+		return nil, nil
+	}
+
+	var connectionInfo client.PostgresConnectionInfo
+	if err := common.Get(func() (*http.Response, error) {
+		return apiClient.RetrievePostgresConnectionInfo(ctx, id)
+	}, &connectionInfo); err != nil {
+		return nil, err
+	}
+
+	return &connectionInfo, nil
+}
+
+// ConnectionInfoModel is the model for the postgres connection info ephemeral resource.
+type ConnectionInfoModel struct {
+	PostgresID               types.String `tfsdk:"postgres_id"`
+	Password                 types.String `tfsdk:"password"`
+	ExternalConnectionString types.String `tfsdk:"external_connection_string"`
+	InternalConnectionString types.String `tfsdk:"internal_connection_string"`
+	PSQLCommand              types.String `tfsdk:"psql_command"`
+}
+
+func ConnectionInfoModelFromClient(id string, c *client.PostgresConnectionInfo) ConnectionInfoModel {
+	return ConnectionInfoModel{
+		PostgresID:               types.StringValue(id),
+		Password:                 types.StringValue(c.Password),
+		ExternalConnectionString: types.StringValue(c.ExternalConnectionString),
+		InternalConnectionString: types.StringValue(c.InternalConnectionString),
+		PSQLCommand:              types.StringValue(c.PsqlCommand),
+	}
+}
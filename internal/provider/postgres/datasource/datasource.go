@@ -60,8 +60,21 @@ func (d *postgresDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	id, err := common.ResolveID(plan.ID, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := postgres.FindByName(ctx, d.client, d.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find Postgres database", err.Error())
+		return
+	}
+	plan.ID = id
+
 	var pg client.PostgresDetail
-	err := common.Get(func() (*http.Response, error) {
+	err = common.Get(func() (*http.Response, error) {
 		return d.client.RetrievePostgres(ctx, plan.ID.ValueString())
 	}, &pg)
 	if err != nil {
@@ -0,0 +1,78 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/postgres"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &postgresInstancesDataSource{}
+	_ datasource.DataSourceWithConfigure = &postgresInstancesDataSource{}
+)
+
+// NewPostgresInstancesDataSource is a helper function to simplify the provider implementation.
+func NewPostgresInstancesDataSource() datasource.DataSource {
+	return &postgresInstancesDataSource{}
+}
+
+// postgresInstancesDataSource is the data source implementation.
+type postgresInstancesDataSource struct {
+	client  *client.ClientWithResponses
+	ownerID string
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *postgresInstancesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := rendertypes.ConfigureDatasource(req, resp)
+	if data == nil {
+		return
+	}
+
+	d.client = data.Client
+	d.ownerID = data.OwnerID
+}
+
+// Metadata returns the data source type name.
+func (d *postgresInstancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_instances"
+}
+
+// Schema defines the schema for the data source.
+func (d *postgresInstancesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = PostgresInstancesDataSourceSchema(ctx)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *postgresInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config postgres.PostgresInstancesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := common.ListFilterFromSets(ctx, config.Names, config.EnvironmentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := postgres.List(ctx, d.client, d.ownerID, filter, 0)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list Postgres databases", err.Error())
+		return
+	}
+
+	config.Instances = make([]postgres.PostgresInstanceModel, 0, len(items))
+	for _, item := range items {
+		config.Instances = append(config.Instances, postgres.PostgresInstanceModelFromClient(item))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -14,23 +14,15 @@ import (
 func PostgresDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Required:            true,
-				Description:         "Unique identifier for this postgres",
-				MarkdownDescription: "Unique identifier for this postgres",
-			},
-			"name": schema.StringAttribute{
-				Computed:            true,
-				Description:         "Descriptive name for this postgres",
-				MarkdownDescription: "Descriptive name for this postgres",
-			},
+			"id":   datasource.LookupID("postgres"),
+			"name": datasource.LookupName("postgres"),
 			"datadog_api_key": schema.StringAttribute{
 				Description:         "Datadog API key to use when sending postgres metrics",
 				MarkdownDescription: "Datadog API key to use when sending postgres metrics",
 				Optional:            true,
 				Sensitive:           true,
 			},
-			"environment_id": datasource.EnvironmentID,
+			"environment_id": datasource.LookupEnvironmentID,
 			"ip_allow_list":  datasource.IPAllowList,
 			"database_name": schema.StringAttribute{
 				CustomType:          commontypes.SuffixStringType{},
@@ -143,3 +135,66 @@ func PostgresDataSourceSchema(ctx context.Context) schema.Schema {
 		},
 	}
 }
+
+func PostgresInstancesDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides every Render Postgres database in the workspace that matches the filters.",
+		MarkdownDescription: "Provides every Render Postgres database in the workspace that matches the filters.",
+		Attributes: map[string]schema.Attribute{
+			"names":           datasource.FilterNames("Postgres databases"),
+			"environment_ids": datasource.FilterEnvironmentIDs("Postgres databases"),
+			"instances": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Postgres databases matching the filters. Use the render_postgres data source to read the full configuration of a database.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the database.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the database.",
+						},
+						"environment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment the database belongs to. Null when it is not in an environment.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Region the database runs in.",
+						},
+						"plan": schema.StringAttribute{
+							Computed:    true,
+							Description: "Plan of the database.",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Postgres major version.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the database, e.g. available or suspended.",
+						},
+						"suspended": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the database is suspended.",
+						},
+						"dashboard_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the database in the Render dashboard.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the database was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the database was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package ephemeral
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides the connection info for a Render Postgres database without storing it in state.",
+		MarkdownDescription: "Provides the connection info for a Render Postgres database without storing it in state or plan files.",
+		Attributes: map[string]schema.Attribute{
+			"postgres_id": schema.StringAttribute{
+				Description:         "Unique identifier for the Postgres database",
+				MarkdownDescription: "Unique identifier for the Postgres database",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				Description:         "Password for the postgres user.",
+				MarkdownDescription: "Password for the postgres user.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"external_connection_string": schema.StringAttribute{
+				Description:         "Connection string for external access. Use this to connect to the database from outside of Render.",
+				MarkdownDescription: "Connection string for external access. Use this to connect to the database from outside of Render.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"internal_connection_string": schema.StringAttribute{
+				Description:         "Connection string for internal access. Use this to connect to the database from within the same Render region.",
+				MarkdownDescription: "Connection string for internal access. Use this to connect to the database from within the same Render region.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"psql_command": schema.StringAttribute{
+				Description:         "Command to connect to the database using the `psql` command line tool.",
+				MarkdownDescription: "Command to connect to the database using the `psql` command line tool.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// List lists the Postgres databases owned by owner, optionally restricted to
+// environments. Read replicas are managed through their primary and are not
+// listed.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string, filter common.ListFilter, limit int64) ([]client.Postgres, error) {
+	res, err := common.ListPages(limit, func(cursor *string, pageSize int) ([]client.PostgresWithCursor, error) {
+		var page []client.PostgresWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListPostgres(ctx, &client.ListPostgresParams{
+				OwnerId:       common.From([]string{owner}),
+				Name:          filter.Names,
+				EnvironmentId: filter.EnvironmentIDs,
+				Cursor:        cursor,
+				Limit:         &pageSize,
+			})
+		}, &page)
+		return page, err
+	}, func(pg client.PostgresWithCursor) string { return pg.Cursor })
+	if err != nil {
+		return nil, fmt.Errorf("could not list postgres: %w", err)
+	}
+
+	databases := make([]client.Postgres, 0, len(res))
+	for _, pg := range res {
+		databases = append(databases, pg.Postgres)
+	}
+	return databases, nil
+}
+
+// FindByName returns the only Postgres database named ref.Name.
+func FindByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, ref common.NameRef) (*client.Postgres, error) {
+	items, err := List(ctx, apiClient, owner, ref.Filter(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	pg, err := common.FindByName("Postgres database", ref, items, func(pg client.Postgres) (string, string) { return pg.Id, pg.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &pg, nil
+}
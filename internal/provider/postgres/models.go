@@ -7,6 +7,7 @@ import (
 	"terraform-provider-render/internal/provider/common"
 	commontypes "terraform-provider-render/internal/provider/common/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -35,6 +36,17 @@ type PostgresModel struct {
 	ParameterOverrides      types.Map                     `tfsdk:"parameter_overrides"`
 }
 
+// PostgresResourceModel holds the write-only Datadog API key and the resource
+// timeouts next to PostgresModel, which is shared with the data source.
+type PostgresResourceModel struct {
+	PostgresModel
+
+	DatadogAPIKeyWO        types.String `tfsdk:"datadog_api_key_wo"`
+	DatadogAPIKeyWOVersion types.Int64  `tfsdk:"datadog_api_key_wo_version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 type ReadReplica struct {
 	Name               types.String `tfsdk:"name"`
 	ID                 types.String `tfsdk:"id"`
@@ -171,6 +183,17 @@ func connectionInfoFromClient(c *client.PostgresConnectionInfo, diags diag.Diagn
 	return objectValue
 }
 
+// ResourceModelFromClient builds the state of the render_postgres resource.
+// The write-only key is never stored, only its version.
+func ResourceModelFromClient(postgres *client.PostgresDetail, connectionInfo *client.PostgresConnectionInfo, logStreamOverrides *logs.ResourceLogStreamSetting, replicaLogStreams map[string]*logs.ResourceLogStreamSetting, existingModel PostgresResourceModel, diags diag.Diagnostics) PostgresResourceModel {
+	return PostgresResourceModel{
+		PostgresModel:          ModelFromClient(postgres, connectionInfo, logStreamOverrides, replicaLogStreams, existingModel.PostgresModel, diags),
+		DatadogAPIKeyWO:        types.StringNull(),
+		DatadogAPIKeyWOVersion: existingModel.DatadogAPIKeyWOVersion,
+		Timeouts:               existingModel.Timeouts,
+	}
+}
+
 func ModelFromClient(postgres *client.PostgresDetail, connectionInfo *client.PostgresConnectionInfo, logStreamOverrides *logs.ResourceLogStreamSetting, replicaLogStreams map[string]*logs.ResourceLogStreamSetting, existingModel PostgresModel, diags diag.Diagnostics) PostgresModel {
 	// Handle parameter_overrides: preserve null if it was null in existing model
 	parameterOverrides := ParameterOverridesToMap(postgres.ParameterOverrides, diags)
@@ -201,3 +224,41 @@ func ModelFromClient(postgres *client.PostgresDetail, connectionInfo *client.Pos
 	}
 	return postgresModel
 }
+
+type PostgresInstancesModel struct {
+	EnvironmentIDs types.Set               `tfsdk:"environment_ids"`
+	Names          types.Set               `tfsdk:"names"`
+	Instances      []PostgresInstanceModel `tfsdk:"instances"`
+}
+
+// PostgresInstanceModel summarizes a Postgres database. Use the render_postgres
+// data source to read its full configuration.
+type PostgresInstanceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Region        types.String `tfsdk:"region"`
+	Plan          types.String `tfsdk:"plan"`
+	Version       types.String `tfsdk:"version"`
+	Status        types.String `tfsdk:"status"`
+	Suspended     types.Bool   `tfsdk:"suspended"`
+	DashboardURL  types.String `tfsdk:"dashboard_url"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+func PostgresInstanceModelFromClient(pg client.Postgres) PostgresInstanceModel {
+	return PostgresInstanceModel{
+		ID:            types.StringValue(pg.Id),
+		Name:          types.StringValue(pg.Name),
+		EnvironmentID: types.StringPointerValue(pg.EnvironmentId),
+		Region:        types.StringValue(string(pg.Region)),
+		Plan:          types.StringValue(string(pg.Plan)),
+		Version:       types.StringValue(string(pg.Version)),
+		Status:        types.StringValue(string(pg.Status)),
+		Suspended:     types.BoolValue(pg.Suspended == client.PostgresSuspendedSuspended),
+		DashboardURL:  types.StringValue(pg.DashboardUrl),
+		CreatedAt:     common.StringFromTime(pg.CreatedAt),
+		UpdatedAt:     common.StringFromTime(pg.UpdatedAt),
+	}
+}
@@ -0,0 +1,45 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/postgres"
+	"terraform-provider-render/internal/provider/types/listresource"
+)
+
+var _ list.ListResourceWithConfigure = &postgresResource{}
+
+// NewPostgresListResource is a helper function to simplify the provider implementation.
+func NewPostgresListResource() list.ListResource {
+	return &postgresResource{}
+}
+
+func (r *postgresResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listresource.Schema("Lists the Postgres databases in a Render workspace.", true)
+}
+
+// List streams the Postgres databases owned by the workspace.
+func (r *postgresResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ownerID, diags := common.ListOwnerID(ctx, req.Config, r.ownerID)
+	environmentIDs, envDiags := common.ListEnvironmentIDs(ctx, req.Config)
+	diags.Append(envDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := postgres.List(ctx, r.client, ownerID, common.ListFilter{EnvironmentIDs: environmentIDs}, req.Limit)
+	if err != nil {
+		diags.AddError("Unable to list Postgres databases", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, r, ownerID, items, func(pg client.Postgres) (string, string) {
+		return pg.Id, pg.Name
+	})
+}
@@ -2,18 +2,20 @@ package resource
 
 import (
 	"context"
+	"fmt"
 	"net/http"
-	"time"
 
 	"terraform-provider-render/internal/provider/common"
 	"terraform-provider-render/internal/provider/postgres"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-render/internal/client"
 	clientpostgres "terraform-provider-render/internal/client/postgres"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -21,6 +23,7 @@ var (
 	_ resource.Resource                = &postgresResource{}
 	_ resource.ResourceWithConfigure   = &postgresResource{}
 	_ resource.ResourceWithImportState = &postgresResource{}
+	_ resource.ResourceWithIdentity    = &postgresResource{}
 )
 
 // NewPostgresResource is a helper function to simplify the provider implementation.
@@ -30,9 +33,10 @@ func NewPostgresResource() resource.Resource {
 
 // postgresResource is the resource implementation.
 type postgresResource struct {
-	client  *client.ClientWithResponses
-	ownerID string
-	poller  *common.Poller
+	client             *client.ClientWithResponses
+	ownerID            string
+	poller             *common.Poller
+	omitConnectionInfo bool
 }
 
 // Configure adds the provider configured Client to the resource.
@@ -44,6 +48,7 @@ func (r *postgresResource) Configure(_ context.Context, req resource.ConfigureRe
 
 	r.client = data.Client
 	r.ownerID = data.OwnerID
+	r.omitConnectionInfo = data.OmitConnectionInfoFromState
 	r.poller = data.Poller
 }
 
@@ -57,15 +62,38 @@ func (r *postgresResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 	resp.Schema = PostgresResourceSchema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *postgresResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *postgresResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan postgres.PostgresModel
+	var plan postgres.PostgresResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultDatastoreTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	datadogAPIKeyWO, diags := common.WriteOnlyString(ctx, req.Config, path.Root("datadog_api_key_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	datadogAPIKey := plan.DatadogAPIKey.ValueStringPointer()
+	if !datadogAPIKeyWO.IsNull() {
+		datadogAPIKey = datadogAPIKeyWO.ValueStringPointer()
+	}
+
 	var pg client.PostgresDetail
 
 	ipAllowList, err := common.ClientFromIPAllowList(plan.IPAllowList)
@@ -75,10 +103,10 @@ func (r *postgresResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	err = common.Create(func() (*http.Response, error) {
-		return r.client.CreatePostgres(ctx, client.PostgresPOSTInput{
+		return r.client.CreatePostgres(createCtx, client.PostgresPOSTInput{
 			DatabaseName:           plan.DatabaseName.ValueStringPointer(),
 			DatabaseUser:           plan.DatabaseUser.ValueStringPointer(),
-			DatadogAPIKey:          plan.DatadogAPIKey.ValueStringPointer(),
+			DatadogAPIKey:          datadogAPIKey,
 			EnableHighAvailability: plan.HighAvailabilityEnabled.ValueBoolPointer(),
 			EnvironmentId:          plan.EnvironmentID.ValueStringPointer(),
 			IpAllowList:            common.From(ipAllowList),
@@ -98,26 +126,25 @@ func (r *postgresResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Poll for postgres to be ready
-	err = r.poller.Poll(ctx, func() (bool, error) {
+	err = r.poller.PollPending(createCtx, func() (string, bool, error) {
 		var polledPG client.PostgresDetail
 		err := common.Get(func() (*http.Response, error) {
-			return r.client.RetrievePostgres(ctx, pg.Id)
+			return r.client.RetrievePostgres(createCtx, pg.Id)
 		}, &polledPG)
 		if err != nil {
-			return false, err
+			return "", false, err
 		}
 
-		return polledPG.Status == client.DatabaseStatusAvailable, nil
-	}, 15*time.Minute)
+		pending := fmt.Sprintf("waiting for postgres %s to become available (status: %s)", pg.Id, polledPG.Status)
+		return pending, polledPG.Status == client.DatabaseStatusAvailable, nil
+	}, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("postgres never became available", err.Error())
 		return
 	}
 
-	var connectionInfo client.PostgresConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrievePostgresConnectionInfo(ctx, pg.Id)
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := postgres.GetConnectionInfo(ctx, r.client, pg.Id, r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get postgres connection info", err.Error())
 		return
 	}
@@ -142,8 +169,9 @@ func (r *postgresResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, postgres.ModelFromClient(&pg, &connectionInfo, logStreamOverrides, replicaLogStreams, plan, resp.Diagnostics))
+	diags = resp.State.Set(ctx, postgres.ResourceModelFromClient(&pg, connectionInfo, logStreamOverrides, replicaLogStreams, plan, resp.Diagnostics))
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 // Read resource information.
@@ -153,7 +181,7 @@ func (r *postgresResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	var state postgres.PostgresModel
+	var state postgres.PostgresResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -176,10 +204,8 @@ func (r *postgresResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	var connectionInfo client.PostgresConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrievePostgresConnectionInfo(ctx, id)
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := postgres.GetConnectionInfo(ctx, r.client, id, r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get postgres connection info", err.Error())
 		return
 	}
@@ -197,19 +223,38 @@ func (r *postgresResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Set refreshed state
-	diags = resp.State.Set(ctx, postgres.ModelFromClient(&pg, &connectionInfo, logStreamOverrides, replicaLogStreams, state, resp.Diagnostics))
+	diags = resp.State.Set(ctx, postgres.ResourceModelFromClient(&pg, connectionInfo, logStreamOverrides, replicaLogStreams, state, resp.Diagnostics))
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *postgresResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan postgres.PostgresModel
+	var plan postgres.PostgresResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state postgres.PostgresModel
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultDatastoreTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	datadogAPIKeyWO, diags := common.WriteOnlyString(ctx, req.Config, path.Root("datadog_api_key_wo"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	datadogAPIKey := plan.DatadogAPIKey.ValueStringPointer()
+	if !datadogAPIKeyWO.IsNull() {
+		datadogAPIKey = datadogAPIKeyWO.ValueStringPointer()
+	}
+
+	var state postgres.PostgresResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -241,7 +286,7 @@ func (r *postgresResource) Update(ctx context.Context, req resource.UpdateReques
 			IpAllowList:            common.From(ipAllowList),
 			Name:                   plan.Name.ValueStringPointer(),
 			Plan:                   common.From(clientpostgres.PostgresPlans(plan.Plan.ValueString())),
-			DatadogAPIKey:          plan.DatadogAPIKey.ValueStringPointer(),
+			DatadogAPIKey:          datadogAPIKey,
 			ReadReplicas:           common.From(postgres.ReadReplicaInputFromModel(plan.ReadReplicas, resp.Diagnostics)),
 			DiskSizeGB:             common.ValueAsIntPointer(plan.DiskSizeGB),
 			ParameterOverrides:     parameterOverrides,
@@ -262,10 +307,8 @@ func (r *postgresResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 	pg.EnvironmentId = envID
 
-	var connectionInfo client.PostgresConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrievePostgresConnectionInfo(ctx, plan.ID.ValueString())
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := postgres.GetConnectionInfo(ctx, r.client, plan.ID.ValueString(), r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get postgres connection info", err.Error())
 		return
 	}
@@ -291,8 +334,9 @@ func (r *postgresResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, postgres.ModelFromClient(&pg, &connectionInfo, logStreamOverrides, replicaLogStreams, plan, resp.Diagnostics))
+	diags = resp.State.Set(ctx, postgres.ResourceModelFromClient(&pg, connectionInfo, logStreamOverrides, replicaLogStreams, plan, resp.Diagnostics))
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *postgresResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -301,6 +345,16 @@ func (r *postgresResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	var stateTimeouts timeouts.Value
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &stateTimeouts)...)
+	deleteTimeout, diags := stateTimeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := common.Delete(func() (*http.Response, error) {
 		return r.client.DeletePostgres(ctx, id)
 	})
@@ -311,6 +365,13 @@ func (r *postgresResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *postgresResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is either the resource ID or a name reference such as
+	// name:my-api or env:<environment_id>/name:my-api.
+	common.ImportStateByName(ctx, req, resp, func(ref common.NameRef) (string, error) {
+		found, err := postgres.FindByName(ctx, r.client, r.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
 }
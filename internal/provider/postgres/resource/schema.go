@@ -3,6 +3,8 @@ package resource
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -41,9 +43,23 @@ func PostgresResourceSchema(ctx context.Context) schema.Schema {
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("datadog_api_key_wo")),
+				},
+			},
+			"datadog_api_key_wo": schema.StringAttribute{
+				Description:         "Write-only Datadog API key to use when sending postgres metrics. It is sent to Render but never stored in state. Requires datadog_api_key_wo_version.",
+				MarkdownDescription: "Write-only Datadog API key to use when sending postgres metrics. It is sent to Render but never stored in state. Requires `datadog_api_key_wo_version`.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("datadog_api_key_wo_version")),
+				},
 			},
-			"environment_id": resource.ResourceEnvironmentID,
-			"ip_allow_list":  resource.IPAllowList,
+			"datadog_api_key_wo_version": resource.WriteOnlyVersion("datadog_api_key_wo"),
+			"environment_id":             resource.ResourceEnvironmentID,
+			"ip_allow_list":              resource.IPAllowList,
 			"database_name": schema.StringAttribute{
 				CustomType:          commontypes.SuffixStringType{},
 				Description:         "Name of the database in the postgres instance",
@@ -130,8 +146,8 @@ func PostgresResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"connection_info": schema.SingleNestedAttribute{
-				Description:         "Database connection info.",
-				MarkdownDescription: "Database connection info.",
+				Description:         "Database connection info. Null when the provider sets omit_connection_info_from_state; use the render_postgres_connection_info ephemeral resource instead.",
+				MarkdownDescription: "Database connection info. Null when the provider sets `omit_connection_info_from_state`; use the `render_postgres_connection_info` ephemeral resource instead.",
 				Computed:            true,
 				Sensitive:           true,
 				Attributes: map[string]schema.Attribute{
@@ -184,5 +200,8 @@ func PostgresResourceSchema(ctx context.Context) schema.Schema {
 			"log_stream_override": resource.LogStreamOverride,
 			"disk_size_gb":        resource.DiskSizeGB,
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resource.Timeouts(ctx),
+		},
 	}
 }
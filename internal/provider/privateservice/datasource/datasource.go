@@ -51,20 +51,33 @@ func (d *privateServiceSource) Schema(ctx context.Context, _ datasource.SchemaRe
 
 // Read refreshes the Terraform state with the latest data.
 func (d *privateServiceSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan privateservice.PrivateServiceModel
+	var plan privateservice.PrivateServiceDataSourceModel
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, d.client, d.ownerID, client.PrivateService, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find private service", err.Error())
+		return
+	}
+	plan.Id = id
+
 	service, err := common.GetWrappedService(ctx, d.client, plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get private service", err.Error())
 		return
 	}
 
-	privateServiceModel, err := privateservice.ModelForServiceResult(service, plan, resp.Diagnostics)
+	privateServiceModel, err := privateservice.DataSourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to apply service result to model", err.Error())
 		return
@@ -12,12 +12,12 @@ func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Private Service.",
 		Attributes: map[string]schema.Attribute{
-			"id":                            datasource.ServiceID,
+			"id":                            datasource.LookupID("private service"),
 			"autoscaling":                   datasource.Autoscaling,
 			"runtime_source":                datasource.RuntimeSource,
 			"disk":                          datasource.Disk,
-			"environment_id":                datasource.ResourceEnvironmentID,
-			"name":                          datasource.ServiceName,
+			"environment_id":                datasource.LookupEnvironmentID,
+			"name":                          datasource.LookupName("private service"),
 			"slug":                          datasource.Slug,
 			"num_instances":                 datasource.NumInstances,
 			"plan":                          datasource.Plan,
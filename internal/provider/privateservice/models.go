@@ -1,6 +1,7 @@
 package privateservice
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -27,11 +28,73 @@ type PrivateServiceModel struct {
 	Url                        types.String               `tfsdk:"url"`
 	MaxShutdownDelaySeconds    types.Int64                `tfsdk:"max_shutdown_delay_seconds"`
 
+	NotificationOverride types.Object `tfsdk:"notification_override"`
+	LogStreamOverride    types.Object `tfsdk:"log_stream_override"`
+}
+
+// PrivateServiceResourceModel is the state of render_private_service. Env vars
+// and secret files live here because the resource also accepts write-only
+// values for them, next to the settings that data sources have no use for.
+type PrivateServiceResourceModel struct {
+	PrivateServiceModel
+
 	EnvVars     map[string]common.EnvVarModel     `tfsdk:"env_vars"`
 	SecretFiles map[string]common.SecretFileModel `tfsdk:"secret_files"`
 
-	NotificationOverride types.Object `tfsdk:"notification_override"`
-	LogStreamOverride    types.Object `tfsdk:"log_stream_override"`
+	IgnoreUnmanagedEnvVars types.Bool              `tfsdk:"ignore_unmanaged_env_vars"`
+	DeployWait             *common.DeployWaitModel `tfsdk:"deploy_wait"`
+	OnUpdate               types.String            `tfsdk:"on_update"`
+	Timeouts               timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// PrivateServiceDataSourceModel is the state of the render_private_service
+// data source.
+type PrivateServiceDataSourceModel struct {
+	PrivateServiceModel
+
+	EnvVars     map[string]common.EnvVarDataSourceModel     `tfsdk:"env_vars"`
+	SecretFiles map[string]common.SecretFileDataSourceModel `tfsdk:"secret_files"`
+}
+
+// ResourceModelForServiceResult builds the state of the
+// render_private_service resource.
+func ResourceModelForServiceResult(service *common.WrappedService, plan PrivateServiceResourceModel, diags diag.Diagnostics) (*PrivateServiceResourceModel, error) {
+	model, err := ModelForServiceResult(service, plan.PrivateServiceModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	envVars := service.EnvVars
+	secretFiles := service.SecretFiles
+	if plan.IgnoreUnmanagedEnvVars.ValueBool() {
+		envVars = common.ListedEnvVars(envVars, plan.EnvVars)
+		secretFiles = common.ListedSecretFiles(secretFiles, plan.SecretFiles)
+	}
+
+	return &PrivateServiceResourceModel{
+		PrivateServiceModel:    *model,
+		EnvVars:                common.EnvVarsFromClientCursors(envVars, plan.EnvVars),
+		SecretFiles:            common.SecretFilesFromClientCursors(secretFiles, plan.SecretFiles),
+		IgnoreUnmanagedEnvVars: plan.IgnoreUnmanagedEnvVars,
+		DeployWait:             plan.DeployWait,
+		OnUpdate:               plan.OnUpdate,
+		Timeouts:               plan.Timeouts,
+	}, nil
+}
+
+// DataSourceModelForServiceResult builds the state of the
+// render_private_service data source.
+func DataSourceModelForServiceResult(service *common.WrappedService, config PrivateServiceDataSourceModel, diags diag.Diagnostics) (*PrivateServiceDataSourceModel, error) {
+	model, err := ModelForServiceResult(service, config.PrivateServiceModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateServiceDataSourceModel{
+		PrivateServiceModel: *model,
+		EnvVars:             common.EnvVarsForDataSource(common.EnvVarsFromClientCursors(service.EnvVars, nil)),
+		SecretFiles:         common.SecretFilesForDataSource(common.SecretFilesFromClientCursors(service.SecretFiles, nil)),
+	}, nil
 }
 
 func ModelForServiceResult(service *common.WrappedService, plan PrivateServiceModel, diags diag.Diagnostics) (*PrivateServiceModel, error) {
@@ -67,8 +130,6 @@ func ModelForServiceResult(service *common.WrappedService, plan PrivateServiceMo
 
 		Autoscaling:          common.AutoscalingFromClient(details.Autoscaling, diags),
 		Disk:                 common.DiskToDiskModel(details.Disk),
-		EnvVars:              common.EnvVarsFromClientCursors(service.EnvVars, plan.EnvVars),
-		SecretFiles:          common.SecretFilesFromClientCursors(service.SecretFiles),
 		NotificationOverride: common.NotificationOverrideFromClient(service.NotificationOverride, diags),
 		LogStreamOverride:    common.LogStreamOverrideFromClient(service.LogStreamOverride, plan.LogStreamOverride, diags),
 	}
@@ -8,7 +8,7 @@ import (
 	"terraform-provider-render/internal/provider/privateservice"
 )
 
-func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan privateservice.PrivateServiceModel) (client.CreateServiceJSONRequestBody, error) {
+func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan privateservice.PrivateServiceResourceModel) (client.CreateServiceJSONRequestBody, error) {
 	envSpecificDetails, err := common.EnvSpecificDetailsForRuntimeSource(
 		plan.RuntimeSource.Runtime(),
 		plan.RuntimeSource,
@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-render/internal/client"
@@ -12,6 +11,7 @@ import (
 	"terraform-provider-render/internal/provider/privateservice"
 	"terraform-provider-render/internal/provider/privateservice/resource/internal"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 	resourcecommon "terraform-provider-render/internal/provider/types/resource"
 )
 
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                     = &privateServiceResource{}
 	_ resource.ResourceWithConfigure        = &privateServiceResource{}
 	_ resource.ResourceWithImportState      = &privateServiceResource{}
+	_ resource.ResourceWithIdentity         = &privateServiceResource{}
 	_ resource.ResourceWithConfigValidators = &privateServiceResource{}
 )
 
@@ -61,16 +62,39 @@ func (r *privateServiceResource) Schema(ctx context.Context, _ resource.SchemaRe
 	resp.Schema = Schema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *privateServiceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *privateServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var shouldWaitForServiceCompletion = r.waitForDeployCompletion
-	var plan privateservice.PrivateServiceModel
+	var plan privateservice.PrivateServiceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+	shouldWaitForServiceCompletion := deployWait.ShouldWait()
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceDetails, err := internal.CreateServiceRequestFromModel(ctx, r.ownerID, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -79,7 +103,7 @@ func (r *privateServiceResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	_, err = common.CreateService(ctx, r.client, common.CreateServiceReq{
+	service, err := common.CreateService(createCtx, r.client, common.CreateServiceReq{
 		Service:              serviceDetails,
 		EnvironmentID:        plan.EnvironmentID.ValueStringPointer(),
 		NotificationOverride: plan.NotificationOverride,
@@ -95,15 +119,22 @@ func (r *privateServiceResource) Create(ctx context.Context, req resource.Create
 		shouldWaitForServiceCompletion = false
 	}
 
-	service, err := common.GetWrappedServiceByName(ctx, r.client, r.ownerID, plan.Name.ValueString(), client.PrivateService)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating private service", "Could not find service, unexpected error: "+err.Error(),
-		)
+	if service == nil {
 		return
 	}
+	if err != nil {
+		// Re-read the partially created service so that it is tracked in
+		// state and can be repaired or destroyed by a later apply.
+		service, err = common.GetWrappedService(ctx, r.client, service.Id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating private service", "Could not read service, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
 
-	model, err := privateservice.ModelForServiceResult(service, plan, resp.Diagnostics)
+	model, err := privateservice.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating private service", "Could not create private service, unexpected error: "+err.Error(),
@@ -114,12 +145,13 @@ func (r *privateServiceResource) Create(ctx context.Context, req resource.Create
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *model)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 
 	if !shouldWaitForServiceCompletion {
 		return
 	}
 
-	err = common.WaitForService(ctx, r.poller, r.client, service.Id)
+	err = common.WaitForService(createCtx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating private service",
@@ -131,7 +163,7 @@ func (r *privateServiceResource) Create(ctx context.Context, req resource.Create
 
 // Read resource information.
 func (r *privateServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state privateservice.PrivateServiceModel
+	var state privateservice.PrivateServiceResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -153,7 +185,7 @@ func (r *privateServiceResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	privateServiceModel, err := privateservice.ModelForServiceResult(service, state, resp.Diagnostics)
+	privateServiceModel, err := privateservice.ResourceModelForServiceResult(service, state, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service",
@@ -163,26 +195,52 @@ func (r *privateServiceResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, privateServiceModel)
+	diags = resp.State.Set(ctx, *privateServiceModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *privateServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan privateservice.PrivateServiceModel
+	var plan privateservice.PrivateServiceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state privateservice.PrivateServiceModel
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+	onUpdate, err := common.UpdateStrategyForPlan(req.State, req.Plan, plan.OnUpdate, r.skipDeployAfterServiceUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service", "Could not determine how to apply the update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state privateservice.PrivateServiceResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.UpdateServiceRequestFromModel(ctx, plan, r.ownerID)
+	serviceDetails, err := internal.UpdateServiceRequestFromModel(ctx, plan.PrivateServiceModel, r.ownerID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service",
@@ -208,11 +266,12 @@ func (r *privateServiceResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	service, err := common.UpdateService(ctx, r.client, r.skipDeployAfterServiceUpdate, common.UpdateServiceReq{
+	service, err := common.UpdateService(ctx, r.client, common.UpdateServiceReq{
 		ServiceID:   plan.Id.ValueString(),
 		Service:     serviceDetails,
 		EnvVars:     evs,
 		SecretFiles: common.SecretFilesToClient(plan.SecretFiles),
+		ListedEnv:   common.NewListedEnv(state.IgnoreUnmanagedEnvVars, plan.IgnoreUnmanagedEnvVars, state.EnvVars, plan.EnvVars, state.SecretFiles, plan.SecretFiles),
 		Disk: &common.DiskStateAndPlan{
 			State: state.Disk,
 			Plan:  plan.Disk,
@@ -231,6 +290,9 @@ func (r *privateServiceResource) Update(ctx context.Context, req resource.Update
 			State: state.LogStreamOverride,
 			Plan:  plan.LogStreamOverride,
 		},
+
+		OnUpdate:              onUpdate,
+		CancelPreviousDeploys: deployWait.CancelPreviousDeploys,
 	}, common.ServiceTypePrivateService)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -239,7 +301,7 @@ func (r *privateServiceResource) Update(ctx context.Context, req resource.Update
 		)
 		return
 	}
-	privateserviceModel, err := privateservice.ModelForServiceResult(service, plan, resp.Diagnostics)
+	privateserviceModel, err := privateservice.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service",
@@ -248,21 +310,39 @@ func (r *privateServiceResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	diags = resp.State.Set(ctx, privateserviceModel)
+	diags = resp.State.Set(ctx, *privateserviceModel)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
+	if resp.Diagnostics.HasError() || !onUpdate.Deploys() || !deployWait.ShouldWait() {
+		return
+	}
+
+	err = common.WaitForService(ctx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service",
+			"Deploy never finished: "+err.Error(),
+		)
 		return
 	}
 }
 
 func (r *privateServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state privateservice.PrivateServiceModel
+	var state privateservice.PrivateServiceResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := common.Delete(func() (*http.Response, error) {
 		return r.client.DeleteService(ctx, state.Id.ValueString())
 	})
@@ -276,8 +356,15 @@ func (r *privateServiceResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *privateServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is either the resource ID or a name reference such as
+	// name:my-api or env:<environment_id>/name:my-api.
+	common.ImportStateByName(ctx, req, resp, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, r.client, r.ownerID, client.PrivateService, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
 }
 
 func (r *privateServiceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
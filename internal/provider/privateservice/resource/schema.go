@@ -30,9 +30,15 @@ func Schema(ctx context.Context) schema.Schema {
 			"url":                           resource.ServiceURL,
 			"max_shutdown_delay_seconds":    resource.MaxShutdownDelaySeconds,
 			"env_vars":                      resource.EnvVars,
+			"ignore_unmanaged_env_vars":     resource.IgnoreUnmanagedEnvVars,
+			"deploy_wait":                   resource.DeployWait,
+			"on_update":                     resource.OnUpdate,
 			"secret_files":                  resource.SecretFiles,
 			"notification_override":         resource.NotificationOverride,
 			"log_stream_override":           resource.LogStreamOverride,
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resource.Timeouts(ctx),
+		},
 	}
 }
@@ -2,9 +2,12 @@ package datasource
 
 import (
 	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
 	"terraform-provider-render/internal/provider/project"
 	rendertypes "terraform-provider-render/internal/provider/types"
 )
@@ -49,6 +52,19 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	id, err := common.ResolveID(proj.Id, proj.Name, types.StringNull(), func(ref common.NameRef) (string, error) {
+		found, err := project.FindByName(ctx, d.client, d.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find project", err.Error())
+		return
+	}
+	proj.Id = id
+
 	projectModel, err := project.Read(ctx, d.client, proj)
 	if err != nil {
 		resp.Diagnostics.AddError(
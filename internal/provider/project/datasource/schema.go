@@ -5,14 +5,54 @@ import (
 	"terraform-provider-render/internal/provider/types/datasource"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func ProjectDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id":           datasource.ProjectID,
-			"name":         datasource.ProjectName,
+			"id":           datasource.LookupID("project"),
+			"name":         datasource.LookupName("project"),
 			"environments": datasource.Environments,
 		},
 	}
 }
+
+func ProjectsDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides every Render project in the workspace that matches the filters.",
+		MarkdownDescription: "Provides every Render project in the workspace that matches the filters.",
+		Attributes: map[string]schema.Attribute{
+			"names": datasource.FilterNames("projects"),
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Projects matching the filters. Use the render_project data source to read their environments.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the project.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the project.",
+						},
+						"environment_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the environments in the project.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the project was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the project was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// List lists the projects owned by owner. Projects are not filtered by
+// environment.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string, filter common.ListFilter, limit int64) ([]client.Project, error) {
+	res, err := common.ListPages(limit, func(cursor *string, pageSize int) ([]client.ProjectWithCursor, error) {
+		var page []client.ProjectWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListProjects(ctx, &client.ListProjectsParams{
+				Name:    filter.Names,
+				OwnerId: common.From([]string{owner}),
+				Cursor:  cursor,
+				Limit:   &pageSize,
+			})
+		}, &page)
+		return page, err
+	}, func(p client.ProjectWithCursor) string { return p.Cursor })
+	if err != nil {
+		return nil, fmt.Errorf("could not list projects: %w", err)
+	}
+
+	projects := make([]client.Project, 0, len(res))
+	for _, p := range res {
+		projects = append(projects, p.Project)
+	}
+	return projects, nil
+}
+
+// FindByName returns the only project named ref.Name. Projects do not
+// belong to an environment, so ref must not name one.
+func FindByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, ref common.NameRef) (*client.Project, error) {
+	if ref.EnvironmentID != nil {
+		return nil, errors.New("projects cannot be looked up by environment")
+	}
+
+	items, err := List(ctx, apiClient, owner, ref.Filter(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := common.FindByName("project", ref, items, func(p client.Project) (string, string) { return p.Id, p.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -132,3 +132,29 @@ func Read(ctx context.Context, c *client.ClientWithResponses, proj ProjectModel)
 	}
 	return &projectModel, nil
 }
+
+type ProjectsModel struct {
+	Names    types.Set             `tfsdk:"names"`
+	Projects []ProjectSummaryModel `tfsdk:"projects"`
+}
+
+// ProjectSummaryModel summarizes a project without the details of its
+// environments. Use the render_project data source to read them.
+type ProjectSummaryModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	EnvironmentIDs types.Set    `tfsdk:"environment_ids"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func ProjectSummaryModelFromClient(ctx context.Context, p client.Project) (ProjectSummaryModel, diag.Diagnostics) {
+	ids, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, p.EnvironmentIds...))
+	return ProjectSummaryModel{
+		ID:             types.StringValue(p.Id),
+		Name:           types.StringValue(p.Name),
+		EnvironmentIDs: ids,
+		CreatedAt:      common.StringFromTime(p.CreatedAt),
+		UpdatedAt:      common.StringFromTime(p.UpdatedAt),
+	}, diags
+}
@@ -0,0 +1,43 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/project"
+	"terraform-provider-render/internal/provider/types/listresource"
+)
+
+var _ list.ListResourceWithConfigure = &projectResource{}
+
+// NewProjectListResource is a helper function to simplify the provider implementation.
+func NewProjectListResource() list.ListResource {
+	return &projectResource{}
+}
+
+func (r *projectResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listresource.Schema("Lists the projects in a Render workspace.", false)
+}
+
+// List streams the projects owned by the workspace.
+func (r *projectResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ownerID, diags := common.ListOwnerID(ctx, req.Config, r.ownerID)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := project.List(ctx, r.client, ownerID, common.ListFilter{}, req.Limit)
+	if err != nil {
+		diags.AddError("Unable to list projects", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, r, ownerID, items, func(p client.Project) (string, string) {
+		return p.Id, p.Name
+	})
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,12 +20,17 @@ import (
 	webhookdatasource "terraform-provider-render/internal/provider/webhook/datasource"
 	webhookresouce "terraform-provider-render/internal/provider/webhook/resource"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -33,11 +40,18 @@ import (
 	dedicatedipdatasource "terraform-provider-render/internal/provider/dedicatedip/datasource"
 	dedicatedipresource "terraform-provider-render/internal/provider/dedicatedip/resource"
 	envgroupresource "terraform-provider-render/internal/provider/envgroup/resource"
+	envvarresource "terraform-provider-render/internal/provider/envvar/resource"
+	"terraform-provider-render/internal/provider/functions"
+	keyvalueephemeral "terraform-provider-render/internal/provider/keyvalue/ephemeral"
 	keyvalueresource "terraform-provider-render/internal/provider/keyvalue/resource"
+	logsdatasource "terraform-provider-render/internal/provider/logs/datasource"
+	metricsdatasource "terraform-provider-render/internal/provider/metrics/datasource"
 	notificationsdatasource "terraform-provider-render/internal/provider/notifications/datasource"
 	privateserviceresource "terraform-provider-render/internal/provider/privateservice/resource"
 	redisdatasource "terraform-provider-render/internal/provider/redis/datasource"
 	redisresource "terraform-provider-render/internal/provider/redis/resource"
+	serviceeventsdatasource "terraform-provider-render/internal/provider/serviceevents/datasource"
+	servicesdatasource "terraform-provider-render/internal/provider/services/datasource"
 	webservicedatasource "terraform-provider-render/internal/provider/webservice/datasource"
 	webserviceresource "terraform-provider-render/internal/provider/webservice/resource"
 
@@ -49,10 +63,15 @@ import (
 	keyvaluedatasource "terraform-provider-render/internal/provider/keyvalue/datasource"
 	notificationsresource "terraform-provider-render/internal/provider/notifications/resource"
 	postgresdatasource "terraform-provider-render/internal/provider/postgres/datasource"
+	postgresephemeral "terraform-provider-render/internal/provider/postgres/ephemeral"
 	postgresresource "terraform-provider-render/internal/provider/postgres/resource"
 	privateservicedatasource "terraform-provider-render/internal/provider/privateservice/datasource"
 	registrycredentialdatasource "terraform-provider-render/internal/provider/registrycredential/datasource"
 	registrycredentialresource "terraform-provider-render/internal/provider/registrycredential/resource"
+	secretfileresource "terraform-provider-render/internal/provider/secretfile/resource"
+	serviceheaderresource "terraform-provider-render/internal/provider/serviceheader/resource"
+	servicepreviewresource "terraform-provider-render/internal/provider/servicepreview/resource"
+	servicerouteresource "terraform-provider-render/internal/provider/serviceroute/resource"
 	staticsitedatasource "terraform-provider-render/internal/provider/staticsite/datasource"
 	staticsiteresource "terraform-provider-render/internal/provider/staticsite/resource"
 	rendertypes "terraform-provider-render/internal/provider/types"
@@ -64,12 +83,19 @@ type renderProviderModel struct {
 	OwnerID                      types.String `tfsdk:"owner_id"`
 	WaitForDeployCompletion      types.Bool   `tfsdk:"wait_for_deploy_completion"`
 	SkipDeployAfterServiceUpdate types.Bool   `tfsdk:"skip_deploy_after_service_update"`
+	OmitConnectionInfoFromState  types.Bool   `tfsdk:"omit_connection_info_from_state"`
+	MaxRetries                   types.Int64  `tfsdk:"max_retries"`
+	MaxRetryBackoffSeconds       types.Int64  `tfsdk:"max_retry_backoff_seconds"`
+	RateLimitRequestsPerMinute   types.Int64  `tfsdk:"rate_limit_requests_per_minute"`
+	RateLimitBurst               types.Int64  `tfsdk:"rate_limit_burst"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider              = &renderProvider{}
-	_ provider.ProviderWithFunctions = &renderProvider{}
+	_ provider.Provider                       = &renderProvider{}
+	_ provider.ProviderWithFunctions          = &renderProvider{}
+	_ provider.ProviderWithEphemeralResources = &renderProvider{}
+	_ provider.ProviderWithListResources      = &renderProvider{}
 )
 
 type ConfigFunc func(provider *renderProvider)
@@ -104,6 +130,12 @@ func WithPoller(poller *common.Poller) ConfigFunc {
 	}
 }
 
+func WithRetryPolicy(retryPolicy RetryPolicy) ConfigFunc {
+	return func(p *renderProvider) {
+		p.retryPolicy = &retryPolicy
+	}
+}
+
 func WithWaitForDeployCompletion(wait bool) ConfigFunc {
 	return func(p *renderProvider) {
 		p.waitForDeployCompletion = wait
@@ -136,8 +168,10 @@ type renderProvider struct {
 	Host                         string
 	httpClient                   *http.Client
 	poller                       *common.Poller
+	retryPolicy                  *RetryPolicy
 	waitForDeployCompletion      bool
 	skipDeployAfterServiceUpdate bool
+	omitConnectionInfoFromState  bool
 }
 
 // Metadata returns the provider type name.
@@ -164,11 +198,44 @@ func (p *renderProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 			},
 			"wait_for_deploy_completion": schema.BoolAttribute{
 				Optional:    true,
-				Description: "If set to true, the provider will wait for deployments to complete when creating web services, private services, and background workers before continuing. This is useful when you have services that depend on one another and the dependencies must be live for the dependent service to successfully start. The default value is false. The provider will read this value from the RENDER_WAIT_FOR_DEPLOY_COMPLETION environment variable if set.",
+				Description: "If set to true, the provider will wait for the deploys it starts when creating or updating services to go live before continuing. Resources can override this with deploy_wait. This is useful when you have services that depend on one another and the dependencies must be live for the dependent service to successfully start. The default value is false. The provider will read this value from the RENDER_WAIT_FOR_DEPLOY_COMPLETION environment variable if set.",
 			},
 			"skip_deploy_after_service_update": schema.BoolAttribute{
+				Optional:           true,
+				Description:        "If set to true, the provider won't deploy a service after updating it. Services that set on_update ignore this setting.",
+				DeprecationMessage: "Set on_update to none on each service instead",
+			},
+			"omit_connection_info_from_state": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true, render_postgres, render_keyvalue, and render_redis resources won't store connection_info in state. Use the render_postgres_connection_info and render_keyvalue_connection_info ephemeral resources to read credentials instead. The default value is false. The provider will read this value from the RENDER_OMIT_CONNECTION_INFO_FROM_STATE environment variable if set.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of times a Render API request is retried when it is rate limited, or when a read, update or delete fails with a 502, 503 or 504 status or a network error. Create requests are only retried when rate limited. The default value is 7. The provider will read this value from the RENDER_MAX_RETRIES environment variable if set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retry_backoff_seconds": schema.Int64Attribute{
 				Optional:    true,
-				Description: "If set to true, the provider won't deploy a service after updating it.",
+				Description: "The maximum number of seconds to wait between two attempts of a Render API request. Waits grow exponentially with jitter up to this value, and waits requested by the API with Retry-After are capped to it. The default value is 120. The provider will read this value from the RENDER_MAX_RETRY_BACKOFF_SECONDS environment variable if set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rate_limit_requests_per_minute": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of Render API requests per minute. The provider sends fewer requests when the rate limit headers of the API show that the limit is close, and also keeps the requests that start deploys within a lower limit. The default value is 400. The provider will read this value from the RENDER_RATE_LIMIT_REQUESTS_PER_MINUTE environment variable if set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of Render API requests that can be sent at once before rate_limit_requests_per_minute applies. The default value is 20. The provider will read this value from the RENDER_RATE_LIMIT_BURST environment variable if set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 		},
 	}
@@ -254,6 +321,25 @@ func (p *renderProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		p.skipDeployAfterServiceUpdate = config.SkipDeployAfterServiceUpdate.ValueBool()
 	}
 
+	p.omitConnectionInfoFromState = false
+	if value := os.Getenv("RENDER_OMIT_CONNECTION_INFO_FROM_STATE"); value != "" {
+		p.omitConnectionInfoFromState = strings.ToLower(value) == "true"
+	}
+
+	if !config.OmitConnectionInfoFromState.IsNull() {
+		p.omitConnectionInfoFromState = config.OmitConnectionInfoFromState.ValueBool()
+	}
+
+	retryPolicy := DefaultRetryPolicy
+	if p.retryPolicy != nil {
+		retryPolicy = *p.retryPolicy
+	}
+	retryPolicy.MaxRetries = int(intSetting(config.MaxRetries, "max_retries", "RENDER_MAX_RETRIES", 0, int64(retryPolicy.MaxRetries), &resp.Diagnostics))
+	retryPolicy.MaxBackoff = time.Duration(intSetting(config.MaxRetryBackoffSeconds, "max_retry_backoff_seconds", "RENDER_MAX_RETRY_BACKOFF_SECONDS", 1, int64(retryPolicy.MaxBackoff/time.Second), &resp.Diagnostics)) * time.Second
+
+	requestsPerMinute := intSetting(config.RateLimitRequestsPerMinute, "rate_limit_requests_per_minute", "RENDER_RATE_LIMIT_REQUESTS_PER_MINUTE", 1, DefaultRequestsPerMinute, &resp.Diagnostics)
+	requestBurst := intSetting(config.RateLimitBurst, "rate_limit_burst", "RENDER_RATE_LIMIT_BURST", 1, DefaultRequestBurst, &resp.Diagnostics)
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -306,9 +392,13 @@ func (p *renderProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		httpClient = p.httpClient
 	}
 
-	// Rate limit the client to 400 requests per minute.
-	renderRateLimit := rate.NewLimiter(rate.Limit(400/60), 1)
-	rateLimitedClient := NewRateLimitHTTPClient(httpClient, renderRateLimit, time.Sleep)
+	// Rate limit the client, with an additional bucket for deploys, and retry
+	// rate limited requests and transient failures according to the retry
+	// policy.
+	renderRateLimit := rate.NewLimiter(rate.Limit(float64(requestsPerMinute)/60), int(requestBurst))
+	deployRateLimit := rate.NewLimiter(rate.Limit(float64(DefaultDeploysPerMinute)/60), DefaultDeployBurst)
+	rateLimitedClient := NewRateLimitHTTPClient(httpClient, renderRateLimit, retryPolicy, SleepContext).
+		WithBucket(NewRateLimitBucket("deploys", IsDeployRequest, deployRateLimit))
 
 	opts = append(opts, client.WithHTTPClient(rateLimitedClient))
 
@@ -335,10 +425,13 @@ func (p *renderProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		Poller:                       p.poller,
 		WaitForDeployCompletion:      p.waitForDeployCompletion,
 		SkipDeployAfterServiceUpdate: p.skipDeployAfterServiceUpdate,
+		OmitConnectionInfoFromState:  p.omitConnectionInfoFromState,
 	}
 
 	resp.DataSourceData = data
 	resp.ResourceData = data
+	resp.EphemeralResourceData = data
+	resp.ListResourceData = data
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -349,14 +442,22 @@ func (p *renderProvider) DataSources(_ context.Context) []func() datasource.Data
 		dedicatedipdatasource.NewDedicatedIPDataSource,
 		envgroupdatasource.NewEnvGroupDataSource,
 		envgroupdatasource.NewEnvGroupLinkDataSource,
+		envgroupdatasource.NewEnvGroupsDataSource,
 		keyvaluedatasource.NewKeyValueSource,
+		keyvaluedatasource.NewKeyValueInstancesDataSource,
+		logsdatasource.NewLogsDataSource,
+		metricsdatasource.NewMetricsDataSource,
 		notificationsdatasource.NewNotificationSettingDataSource,
 		logstreamdatasource.NewLogStreamSettingDataSource,
 		postgresdatasource.NewPostgresDataSource,
+		postgresdatasource.NewPostgresInstancesDataSource,
 		privateservicedatasource.NewPrivateServiceSource,
 		projectdatasource.NewProjectDataSource,
+		projectdatasource.NewProjectsDataSource,
 		redisdatasource.NewRedisSource,
 		registrycredentialdatasource.NewRegistryDataSource,
+		serviceeventsdatasource.NewServiceEventsDataSource,
+		servicesdatasource.NewServicesDataSource,
 		staticsitedatasource.NewStaticSiteSource,
 		webservicedatasource.NewWebServiceSource,
 		webhookdatasource.NewWebhookDataSource,
@@ -372,6 +473,9 @@ func (p *renderProvider) Resources(_ context.Context) []func() resource.Resource
 		dedicatedipresource.NewDedicatedIPResource,
 		envgroupresource.NewEnvGroupResource,
 		envgroupresource.NewEnvGroupLinkResource,
+		envgroupresource.NewEnvGroupEnvVarResource,
+		envgroupresource.NewEnvGroupSecretFileResource,
+		envvarresource.NewEnvVarResource,
 		keyvalueresource.NewKeyValueResource,
 		logstreamresource.NewLogStreamSettingResource,
 		notificationsresource.NewNotificationSettingResource,
@@ -380,6 +484,10 @@ func (p *renderProvider) Resources(_ context.Context) []func() resource.Resource
 		projectresource.NewProjectResource,
 		redisresource.NewRedisResource,
 		registrycredentialresource.NewRegistryCredentialResource,
+		secretfileresource.NewSecretFileResource,
+		serviceheaderresource.NewServiceHeaderResource,
+		servicepreviewresource.NewServicePreviewResource,
+		servicerouteresource.NewServiceRouteResource,
 		staticsiteresource.NewStaticSiteResource,
 		webserviceresource.NewWebServiceResource,
 		webhookresouce.NewWebhookResource,
@@ -387,6 +495,61 @@ func (p *renderProvider) Resources(_ context.Context) []func() resource.Resource
 	}
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *renderProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		keyvalueephemeral.NewKeyValueConnectionInfoEphemeralResource,
+		postgresephemeral.NewPostgresConnectionInfoEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *renderProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		backgroundwokrerresource.NewBackgroundWorkerListResource,
+		cronjobresource.NewCronJobListResource,
+		envgroupresource.NewEnvGroupListResource,
+		keyvalueresource.NewKeyValueListResource,
+		postgresresource.NewPostgresListResource,
+		privateserviceresource.NewPrivateServiceListResource,
+		projectresource.NewProjectListResource,
+		registrycredentialresource.NewRegistryCredentialListResource,
+		staticsiteresource.NewStaticSiteListResource,
+		webserviceresource.NewWebServiceListResource,
+	}
+}
+
 func (p *renderProvider) Functions(_ context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		functions.NewBuildPostgresURLFunction,
+		functions.NewDecodeBlueprintFunction,
+		functions.NewDotenvDecodeFunction,
+		functions.NewDotenvEncodeFunction,
+		functions.NewParsePostgresURLFunction,
+		functions.NewParseRedisURLFunction,
+	}
+}
+
+// intSetting returns the value of an integer provider attribute, falling back
+// to the environment variable envVar and then to def.
+func intSetting(config types.Int64, attribute, envVar string, minimum, def int64, diags *diag.Diagnostics) int64 {
+	if !config.IsNull() {
+		return config.ValueInt64()
+	}
+
+	value := os.Getenv(envVar)
+	if value == "" {
+		return def
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < minimum {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Provider Configuration",
+			fmt.Sprintf("The %s environment variable must be an integer of at least %d, got %q.", envVar, minimum, value),
+		)
+		return def
+	}
+	return n
 }
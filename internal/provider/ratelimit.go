@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultRequestsPerMinute is the general rate limit of the Render API.
+	DefaultRequestsPerMinute = 400
+	DefaultRequestBurst      = 20
+
+	// DefaultDeploysPerMinute is the rate limit of the endpoints that start
+	// deploys, which Render limits separately.
+	DefaultDeploysPerMinute = 20
+	DefaultDeployBurst      = 5
+)
+
+// RateLimitBucket throttles the requests that match it. Its configured rate is
+// an upper bound: the bucket slows down when the rate limit headers of the
+// Render API show that fewer requests remain in the current window, and stops
+// sending requests until the window resets when none remain.
+type RateLimitBucket struct {
+	name    string
+	match   func(*http.Request) bool
+	limiter *rate.Limiter
+	limit   rate.Limit
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func NewRateLimitBucket(name string, match func(*http.Request) bool, limiter *rate.Limiter) *RateLimitBucket {
+	return &RateLimitBucket{
+		name:    name,
+		match:   match,
+		limiter: limiter,
+		limit:   limiter.Limit(),
+	}
+}
+
+var deployPath = regexp.MustCompile(`/services/[^/]+/(deploys(/[^/]+/cancel)?|rollback|restart)$`)
+
+// IsDeployRequest reports whether req starts, cancels or restarts a deploy.
+func IsDeployRequest(req *http.Request) bool {
+	return req.Method == http.MethodPost && deployPath.MatchString(req.URL.Path)
+}
+
+// wait blocks until the bucket allows another request, logging when the
+// request is throttled.
+func (b *RateLimitBucket) wait(ctx context.Context, sleepFunc func(context.Context, time.Duration) error) error {
+	b.mu.Lock()
+	pause := time.Until(b.pausedUntil)
+	b.mu.Unlock()
+
+	if pause > 0 {
+		tflog.Info(ctx, "Render API rate limit exhausted, waiting for it to reset", map[string]any{
+			"bucket": b.name,
+			"wait":   pause.Round(time.Millisecond).String(),
+		})
+		if err := sleepFunc(ctx, pause); err != nil {
+			return err
+		}
+	}
+
+	start := time.Now()
+	if err := b.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	if waited := time.Since(start); waited >= time.Second {
+		tflog.Debug(ctx, "Throttled Render API request", map[string]any{
+			"bucket": b.name,
+			"wait":   waited.Round(time.Millisecond).String(),
+			"limit":  float64(b.limiter.Limit()),
+		})
+	}
+	return nil
+}
+
+// adapt updates the bucket from the rate limit headers of a response. The
+// requests that remain in the window are spread evenly over the time left
+// until it resets, without going over the configured rate.
+func (b *RateLimitBucket) adapt(ctx context.Context, header http.Header, now time.Time) {
+	remaining, err := strconv.Atoi(header.Get("Ratelimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, ok := rateLimitReset(header.Get("Ratelimit-Reset"), now)
+	if !ok {
+		return
+	}
+
+	if remaining <= 0 {
+		b.mu.Lock()
+		if now.Add(reset).After(b.pausedUntil) {
+			b.pausedUntil = now.Add(reset)
+		}
+		b.mu.Unlock()
+		return
+	}
+
+	limit := b.limit
+	if reset > 0 {
+		limit = min(b.limit, rate.Limit(float64(remaining)/reset.Seconds()))
+	}
+	if limit == b.limiter.Limit() {
+		return
+	}
+
+	if limit < b.limiter.Limit() {
+		tflog.Debug(ctx, "Slowing down Render API requests", map[string]any{
+			"bucket":    b.name,
+			"remaining": remaining,
+			"reset":     reset.String(),
+			"limit":     float64(limit),
+		})
+	}
+	b.limiter.SetLimitAt(now, limit)
+}
+
+// rateLimitReset parses a Ratelimit-Reset header, which holds either the
+// number of seconds until the window resets or the reset time in Unix seconds.
+func rateLimitReset(value string, now time.Time) (time.Duration, bool) {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+
+	// No window lasts anywhere close to 30 years, so larger values are
+	// timestamps.
+	if seconds > 1e9 {
+		return max(time.Unix(seconds, 0).Sub(now), 0), true
+	}
+	return time.Duration(seconds) * time.Second, true
+}
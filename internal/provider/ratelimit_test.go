@@ -0,0 +1,148 @@
+package provider_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"terraform-provider-render/internal/provider"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitBuckets(t *testing.T) {
+	t.Run("slows down when few requests remain", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Ratelimit-Limit", "400")
+			w.Header().Set("Ratelimit-Remaining", "30")
+			w.Header().Set("Ratelimit-Reset", "60")
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		limiter := rate.NewLimiter(rate.Limit(10), 10)
+		client := provider.NewRateLimitHTTPClient(svr.Client(), limiter, provider.DefaultRetryPolicy, noSleep)
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.NoError(t, err)
+		require.InDelta(t, 0.5, float64(limiter.Limit()), 0.001)
+	})
+
+	t.Run("never goes over the configured rate", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Ratelimit-Remaining", "1000")
+			w.Header().Set("Ratelimit-Reset", "1")
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		limiter := rate.NewLimiter(rate.Limit(10), 10)
+		client := provider.NewRateLimitHTTPClient(svr.Client(), limiter, provider.DefaultRetryPolicy, noSleep)
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, rate.Limit(10), limiter.Limit())
+	})
+
+	t.Run("waits for the window to reset when no requests remain", func(t *testing.T) {
+		var callCount int
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			if callCount == 1 {
+				w.Header().Set("Ratelimit-Remaining", "0")
+				w.Header().Set("Ratelimit-Reset", "20")
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		var sleeps []time.Duration
+		fakeSleep := func(_ context.Context, d time.Duration) error {
+			sleeps = append(sleeps, d)
+			return nil
+		}
+
+		client := provider.NewRateLimitHTTPClient(svr.Client(), rate.NewLimiter(rate.Limit(10), 10), provider.DefaultRetryPolicy, fakeSleep)
+
+		for range 2 {
+			req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+			require.NoError(t, err)
+
+			_, err = client.Do(req)
+			require.NoError(t, err)
+		}
+
+		require.Len(t, sleeps, 1)
+		require.InDelta(t, 20*time.Second, sleeps[0], float64(time.Second))
+	})
+
+	t.Run("throttles deploys separately", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/services/srv-1/deploys" {
+				w.Header().Set("Ratelimit-Remaining", "1")
+				w.Header().Set("Ratelimit-Reset", "10")
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		limiter := rate.NewLimiter(rate.Limit(10), 10)
+		deployLimiter := rate.NewLimiter(rate.Limit(1), 1)
+		client := provider.NewRateLimitHTTPClient(svr.Client(), limiter, provider.DefaultRetryPolicy, noSleep).
+			WithBucket(provider.NewRateLimitBucket("deploys", provider.IsDeployRequest, deployLimiter))
+
+		req, err := http.NewRequest(http.MethodPost, svr.URL+"/services/srv-1/deploys", nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.NoError(t, err)
+		require.InDelta(t, 0.1, float64(deployLimiter.Limit()), 0.001)
+		require.Equal(t, rate.Limit(10), limiter.Limit())
+	})
+
+	t.Run("counts deploys against the general rate limit", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		limiter := rate.NewLimiter(rate.Limit(0.001), 2)
+		deployLimiter := rate.NewLimiter(rate.Limit(0.001), 2)
+		client := provider.NewRateLimitHTTPClient(svr.Client(), limiter, provider.DefaultRetryPolicy, noSleep).
+			WithBucket(provider.NewRateLimitBucket("deploys", provider.IsDeployRequest, deployLimiter))
+
+		req, err := http.NewRequest(http.MethodPost, svr.URL+"/services/srv-1/deploys", nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		require.NoError(t, err)
+		require.InDelta(t, 1, deployLimiter.Tokens(), 0.01)
+		require.InDelta(t, 1, limiter.Tokens(), 0.01)
+	})
+}
+
+func TestIsDeployRequest(t *testing.T) {
+	tcs := []struct {
+		method   string
+		path     string
+		expected bool
+	}{
+		{method: http.MethodPost, path: "/v1/services/srv-1/deploys", expected: true},
+		{method: http.MethodPost, path: "/v1/services/srv-1/deploys/dep-1/cancel", expected: true},
+		{method: http.MethodPost, path: "/v1/services/srv-1/rollback", expected: true},
+		{method: http.MethodPost, path: "/v1/services/srv-1/restart", expected: true},
+		{method: http.MethodGet, path: "/v1/services/srv-1/deploys", expected: false},
+		{method: http.MethodPost, path: "/v1/services", expected: false},
+		{method: http.MethodPost, path: "/v1/postgres/dpg-1/restart", expected: false},
+	}
+
+	for _, tc := range tcs {
+		req, err := http.NewRequest(tc.method, "https://api.render.com"+tc.path, nil)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, provider.IsDeployRequest(req), "%s %s", tc.method, tc.path)
+	}
+}
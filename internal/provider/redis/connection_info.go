@@ -0,0 +1,26 @@
+package redis
+
+import (
+	"context"
+	"net/http"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// GetConnectionInfo fetches the credentials for a redis instance. When omit is
+// set it returns nil so that connection_info is kept out of state.
+func GetConnectionInfo(ctx context.Context, apiClient *client.ClientWithResponses, id string, omit bool) (*client.RedisConnectionInfo, error) {
+	if omit {
+		return nil, nil
+	}
+
+	var connectionInfo client.RedisConnectionInfo
+	if err := common.Get(func() (*http.Response, error) {
+		return apiClient.RetrieveRedisConnectionInfo(ctx, id)
+	}, &connectionInfo); err != nil {
+		return nil, err
+	}
+
+	return &connectionInfo, nil
+}
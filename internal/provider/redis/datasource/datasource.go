@@ -61,6 +61,19 @@ func (d *redisSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := redis.FindByName(ctx, d.client, d.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find Redis instance", err.Error())
+		return
+	}
+	plan.Id = id
+
 	var redisResponse client.Redis
 	if err := common.Get(func() (*http.Response, error) {
 		return d.client.RetrieveRedis(ctx, plan.Id.ValueString())
@@ -13,12 +13,12 @@ func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Redis instance.",
 		Attributes: map[string]schema.Attribute{
-			"id":                  datasource.ServiceID,
-			"environment_id":      datasource.ResourceEnvironmentID,
+			"id":                  datasource.LookupID("Redis instance"),
+			"environment_id":      datasource.LookupEnvironmentID,
 			"ip_allow_list":       datasource.IPAllowList,
 			"max_memory_policy":   datasource.MaxMemoryPolicy,
 			"persistence_mode":    datasource.PersistenceMode,
-			"name":                datasource.ServiceName,
+			"name":                datasource.LookupName("Redis instance"),
 			"plan":                datasource.RedisPlan,
 			"region":              datasource.Region,
 			"connection_info":     datasource.RedisConnectionInfo,
@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// List lists the Redis instances owned by owner, optionally restricted to
+// environments.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string, filter common.ListFilter, limit int64) ([]client.Redis, error) {
+	res, err := common.ListPages(limit, func(cursor *string, pageSize int) ([]client.RedisWithCursor, error) {
+		var page []client.RedisWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListRedis(ctx, &client.ListRedisParams{
+				OwnerId:       common.From([]string{owner}),
+				Name:          filter.Names,
+				EnvironmentId: filter.EnvironmentIDs,
+				Cursor:        cursor,
+				Limit:         &pageSize,
+			})
+		}, &page)
+		return page, err
+	}, func(r client.RedisWithCursor) string { return r.Cursor })
+	if err != nil {
+		return nil, fmt.Errorf("could not list redis instances: %w", err)
+	}
+
+	instances := make([]client.Redis, 0, len(res))
+	for _, r := range res {
+		instances = append(instances, r.Redis)
+	}
+	return instances, nil
+}
+
+// FindByName returns the only Redis instance named ref.Name.
+func FindByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, ref common.NameRef) (*client.Redis, error) {
+	items, err := List(ctx, apiClient, owner, ref.Filter(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := common.FindByName("Redis instance", ref, items, func(r client.Redis) (string, string) { return r.Id, r.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
@@ -4,6 +4,7 @@ import (
 	"terraform-provider-render/internal/client/logs"
 	"terraform-provider-render/internal/provider/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,6 +25,12 @@ type RedisModel struct {
 	LogStreamOverride types.Object `tfsdk:"log_stream_override"`
 }
 
+// RedisResourceModel adds the resource timeouts to RedisModel.
+type RedisResourceModel struct {
+	RedisModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 var connectionInfoTypes = map[string]attr.Type{
 	"external_connection_string": types.StringType,
 	"internal_connection_string": types.StringType,
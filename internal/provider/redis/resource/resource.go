@@ -31,8 +31,9 @@ func NewRedisResource() resource.Resource {
 
 // redisResource is the resource implementation.
 type redisResource struct {
-	client  *client.ClientWithResponses
-	ownerID string
+	client             *client.ClientWithResponses
+	ownerID            string
+	omitConnectionInfo bool
 }
 
 // Configure adds the provider configured Client to the resource.
@@ -44,6 +45,7 @@ func (r *redisResource) Configure(_ context.Context, req resource.ConfigureReque
 
 	r.client = data.Client
 	r.ownerID = data.OwnerID
+	r.omitConnectionInfo = data.OmitConnectionInfoFromState
 }
 
 // Metadata returns the resource type name.
@@ -58,14 +60,22 @@ func (r *redisResource) Schema(ctx context.Context, _ resource.SchemaRequest, re
 
 // Create a new resource.
 func (r *redisResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan redis.RedisModel
+	var plan redis.RedisResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.CreateRedisRequestFromModel(r.ownerID, plan)
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultDatastoreTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	serviceDetails, err := internal.CreateRedisRequestFromModel(r.ownerID, plan.RedisModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating service", "Could not create service, unexpected error: "+err.Error(),
@@ -75,17 +85,15 @@ func (r *redisResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	var model client.Redis
 	err = common.Create(func() (*http.Response, error) {
-		return r.client.CreateRedis(ctx, serviceDetails)
+		return r.client.CreateRedis(createCtx, serviceDetails)
 	}, &model)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating service", err.Error())
 		return
 	}
 
-	var connectionInfo client.RedisConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrieveRedisConnectionInfo(ctx, model.Id)
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := redis.GetConnectionInfo(ctx, r.client, model.Id, r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get redis connection info", err.Error())
 		return
 	}
@@ -102,16 +110,16 @@ func (r *redisResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("unable to create log stream overrides", err.Error())
 		return
 	}
-	redisModel := redis.ModelForRedisResult(&model, &plan, &connectionInfo, logStreamOverrides, resp.Diagnostics)
+	redisModel := redis.ModelForRedisResult(&model, &plan.RedisModel, connectionInfo, logStreamOverrides, resp.Diagnostics)
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, redisModel)
+	diags = resp.State.Set(ctx, redis.RedisResourceModel{RedisModel: *redisModel, Timeouts: plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 }
 
 // Read resource information.
 func (r *redisResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state redis.RedisModel
+	var state redis.RedisResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -134,10 +142,8 @@ func (r *redisResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	var connectionInfo client.RedisConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrieveRedisConnectionInfo(ctx, clientRedis.Id)
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := redis.GetConnectionInfo(ctx, r.client, clientRedis.Id, r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get redis connection info", err.Error())
 		return
 	}
@@ -148,29 +154,37 @@ func (r *redisResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	redisModel := redis.ModelForRedisResult(&clientRedis, &state, &connectionInfo, logStreamOverrides, resp.Diagnostics)
+	redisModel := redis.ModelForRedisResult(&clientRedis, &state.RedisModel, connectionInfo, logStreamOverrides, resp.Diagnostics)
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, redisModel)
+	diags = resp.State.Set(ctx, redis.RedisResourceModel{RedisModel: *redisModel, Timeouts: state.Timeouts})
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *redisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan redis.RedisModel
+	var plan redis.RedisResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state redis.RedisModel
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultDatastoreTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state redis.RedisResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.UpdateServiceRequestFromModel(plan)
+	serviceDetails, err := internal.UpdateServiceRequestFromModel(plan.RedisModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service", "Could not update service, unexpected error: "+err.Error(),
@@ -189,10 +203,8 @@ func (r *redisResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var connectionInfo client.RedisConnectionInfo
-	if err = common.Get(func() (*http.Response, error) {
-		return r.client.RetrieveRedisConnectionInfo(ctx, redisResponse.Id)
-	}, &connectionInfo); err != nil {
+	connectionInfo, err := redis.GetConnectionInfo(ctx, r.client, redisResponse.Id, r.omitConnectionInfo)
+	if err != nil {
 		resp.Diagnostics.AddError("unable to get redis connection info", err.Error())
 		return
 	}
@@ -211,7 +223,7 @@ func (r *redisResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	redisModel := redis.ModelForRedisResult(&redisResponse, &plan, &connectionInfo, logStreamOverrides, resp.Diagnostics)
+	redisModel := redis.ModelForRedisResult(&redisResponse, &plan.RedisModel, connectionInfo, logStreamOverrides, resp.Diagnostics)
 
 	envID, err := common.UpdateEnvironmentID(ctx, r.client, redisModel.Id.ValueString(), &common.EnvironmentIDStateAndPlan{
 		State: state.EnvironmentID.ValueStringPointer(),
@@ -226,7 +238,7 @@ func (r *redisResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	redisModel.EnvironmentID = types.StringPointerValue(envID)
 
-	diags = resp.State.Set(ctx, redisModel)
+	diags = resp.State.Set(ctx, redis.RedisResourceModel{RedisModel: *redisModel, Timeouts: plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -234,13 +246,21 @@ func (r *redisResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *redisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state redis.RedisModel
+	var state redis.RedisResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := common.Delete(func() (*http.Response, error) {
 		return r.client.DeleteRedis(ctx, state.Id.ValueString())
 	})
@@ -23,5 +23,8 @@ func Schema(ctx context.Context) schema.Schema {
 			"connection_info":     resource.RedisConnectionInfo,
 			"log_stream_override": resource.LogStreamOverride,
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resource.Timeouts(ctx),
+		},
 	}
 }
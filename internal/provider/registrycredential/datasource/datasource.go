@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/registrycredential"
 	rendertypes "terraform-provider-render/internal/provider/types"
 )
 
@@ -57,6 +59,19 @@ func (d *registryDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, types.StringNull(), func(ref common.NameRef) (string, error) {
+		found, err := registrycredential.FindByName(ctx, d.client, d.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find registry credential", err.Error())
+		return
+	}
+	plan.Id = id
+
 	registryCredentials, err := d.client.RetrieveRegistryCredentialWithResponse(ctx, plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get registry credentials", err.Error())
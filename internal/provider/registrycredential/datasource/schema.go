@@ -6,20 +6,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"terraform-provider-render/internal/provider/types/datasource"
 )
 
 func RegistryCredentialDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Registry Credential.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "Unique identifier for this credential",
-			},
-			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Descriptive name for this credential",
-			},
+			"id":   datasource.LookupID("credential"),
+			"name": datasource.LookupName("credential"),
 			"registry": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The registry to use this credential with. One of GITHUB, GITLAB, DOCKER, AWS_ECR, GOOGLE_ARTIFACT.",
@@ -0,0 +1,54 @@
+package registrycredential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// List lists the registry credentials owned by owner. Registry credentials are
+// returned without cursors, so only a single page can be fetched, and they are
+// not filtered by environment.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string, filter common.ListFilter, limit int64) ([]client.RegistryCredential, error) {
+	pageSize := 100
+	if limit > 0 && limit < int64(pageSize) {
+		pageSize = int(limit)
+	}
+
+	var res []client.RegistryCredential
+	err := common.Get(func() (*http.Response, error) {
+		return apiClient.ListRegistryCredentials(ctx, &client.ListRegistryCredentialsParams{
+			Name:    filter.Names,
+			OwnerId: common.From([]string{owner}),
+			Limit:   &pageSize,
+		})
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not list registry credentials: %w", err)
+	}
+
+	return res, nil
+}
+
+// FindByName returns the only registry credential named ref.Name. Registry credentials do not
+// belong to an environment, so ref must not name one.
+func FindByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, ref common.NameRef) (*client.RegistryCredential, error) {
+	if ref.EnvironmentID != nil {
+		return nil, errors.New("registry credentials cannot be looked up by environment")
+	}
+
+	items, err := List(ctx, apiClient, owner, ref.Filter(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := common.FindByName("registry credential", ref, items, func(rc client.RegistryCredential) (string, string) { return rc.Id, rc.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &rc, nil
+}
@@ -0,0 +1,43 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/registrycredential"
+	"terraform-provider-render/internal/provider/types/listresource"
+)
+
+var _ list.ListResourceWithConfigure = &registryCredentialResource{}
+
+// NewRegistryCredentialListResource is a helper function to simplify the provider implementation.
+func NewRegistryCredentialListResource() list.ListResource {
+	return &registryCredentialResource{}
+}
+
+func (r *registryCredentialResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listresource.Schema("Lists the registry credentials in a Render workspace.", false)
+}
+
+// List streams the registry credentials owned by the workspace.
+func (r *registryCredentialResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ownerID, diags := common.ListOwnerID(ctx, req.Config, r.ownerID)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := registrycredential.List(ctx, r.client, ownerID, common.ListFilter{}, req.Limit)
+	if err != nil {
+		diags.AddError("Unable to list registry credentials", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, r, ownerID, items, func(rc client.RegistryCredential) (string, string) {
+		return rc.Id, rc.Name
+	})
+}
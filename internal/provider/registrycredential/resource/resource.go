@@ -5,13 +5,16 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-render/internal/client"
 	"terraform-provider-render/internal/provider/common"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -19,6 +22,7 @@ var (
 	_ resource.Resource                = &registryCredentialResource{}
 	_ resource.ResourceWithConfigure   = &registryCredentialResource{}
 	_ resource.ResourceWithImportState = &registryCredentialResource{}
+	_ resource.ResourceWithIdentity    = &registryCredentialResource{}
 )
 
 // NewregistryCredentialResource is a helper function to simplify the provider implementation.
@@ -53,6 +57,11 @@ func (r *registryCredentialResource) Schema(ctx context.Context, _ resource.Sche
 	resp.Schema = RegistryCredentialResourceSchema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *registryCredentialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *registryCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -63,6 +72,12 @@ func (r *registryCredentialResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	authToken, diags := authTokenFromConfig(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var registry client.RegistryCredentialRegistry
 	switch plan.Registry {
 	case types.StringValue("GITHUB"):
@@ -84,7 +99,7 @@ func (r *registryCredentialResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	requestBody := client.CreateRegistryCredentialJSONRequestBody{
-		AuthToken: plan.AuthToken.ValueString(),
+		AuthToken: authToken,
 		Name:      plan.Name.ValueString(),
 		OwnerId:   r.ownerID,
 		Registry:  registry,
@@ -116,6 +131,7 @@ func (r *registryCredentialResource) Create(ctx context.Context, req resource.Cr
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -185,6 +201,7 @@ func (r *registryCredentialResource) Read(ctx context.Context, req resource.Read
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -199,8 +216,14 @@ func (r *registryCredentialResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	authToken, diags := authTokenFromConfig(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	requestBody := client.UpdateRegistryCredentialJSONRequestBody{
-		AuthToken: plan.AuthToken.ValueString(),
+		AuthToken: authToken,
 		Name:      plan.Name.ValueString(),
 		Registry:  client.RegistryCredentialRegistry(plan.Registry.ValueString()),
 		Username:  plan.Username.ValueString(),
@@ -239,6 +262,7 @@ func (r *registryCredentialResource) Update(ctx context.Context, req resource.Up
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -265,8 +289,18 @@ func (r *registryCredentialResource) Delete(ctx context.Context, req resource.De
 	}
 }
 
+// authTokenFromConfig returns the auth token to send to Render. Write-only
+// values are null in the plan, so auth_token_wo is read from the config.
+func authTokenFromConfig(ctx context.Context, config tfsdk.Config, plan RegistryCredentialModel) (string, diag.Diagnostics) {
+	authTokenWO, diags := common.WriteOnlyString(ctx, config, path.Root("auth_token_wo"))
+	if !authTokenWO.IsNull() {
+		return authTokenWO.ValueString(), diags
+	}
+	return plan.AuthToken.ValueString(), diags
+}
+
 func (r *registryCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// todo
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
 	"terraform-provider-render/internal/provider/common/validators"
+	"terraform-provider-render/internal/provider/types/resource"
 )
 
 func RegistryCredentialResourceSchema(ctx context.Context) schema.Schema {
@@ -26,11 +28,27 @@ func RegistryCredentialResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"auth_token": schema.StringAttribute{
-				Required:    true,
-				Sensitive:   true,
-				Description: "The auth token to use when pulling the image",
-				Validators:  []validator.String{validators.StringNotEmpty},
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "The auth token to use when pulling the image. Exactly one of auth_token or auth_token_wo must be set.",
+				MarkdownDescription: "The auth token to use when pulling the image. Exactly one of `auth_token` or `auth_token_wo` must be set.",
+				Validators: []validator.String{
+					validators.StringNotEmpty,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("auth_token_wo")),
+				},
+			},
+			"auth_token_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Description:         "Write-only auth token to use when pulling the image. It is sent to Render but never stored in state. Requires auth_token_wo_version.",
+				MarkdownDescription: "Write-only auth token to use when pulling the image. It is sent to Render but never stored in state. Requires `auth_token_wo_version`.",
+				Validators: []validator.String{
+					validators.StringNotEmpty,
+					stringvalidator.AlsoRequires(path.MatchRoot("auth_token_wo_version")),
+				},
 			},
+			"auth_token_wo_version": resource.WriteOnlyVersion("auth_token_wo"),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "Descriptive name for this credential",
@@ -60,9 +78,11 @@ func RegistryCredentialResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type RegistryCredentialModel struct {
-	AuthToken types.String `tfsdk:"auth_token"`
-	Id        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Registry  types.String `tfsdk:"registry"`
-	Username  types.String `tfsdk:"username"`
+	AuthToken          types.String `tfsdk:"auth_token"`
+	AuthTokenWO        types.String `tfsdk:"auth_token_wo"`
+	AuthTokenWOVersion types.Int64  `tfsdk:"auth_token_wo_version"`
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Registry           types.String `tfsdk:"registry"`
+	Username           types.String `tfsdk:"username"`
 }
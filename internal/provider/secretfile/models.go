@@ -0,0 +1,48 @@
+package secretfile
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// Model is a single secret file of a service, managed separately from the
+// service's secret_files.
+type Model struct {
+	ID               types.String `tfsdk:"id"`
+	ServiceID        types.String `tfsdk:"service_id"`
+	Name             types.String `tfsdk:"name"`
+	Content          types.String `tfsdk:"content"`
+	ContentWO        types.String `tfsdk:"content_wo"`
+	ContentWOVersion types.Int64  `tfsdk:"content_wo_version"`
+}
+
+// SecretFile returns the content of the secret file in the form shared with
+// secret_files.
+func (m Model) SecretFile() common.SecretFileModel {
+	return common.SecretFileModel{
+		Content:          m.Content,
+		ContentWO:        m.ContentWO,
+		ContentWOVersion: m.ContentWOVersion,
+	}
+}
+
+// ModelFromClient builds the state of a secret file. The write-only version is
+// not returned by the API, so it is kept from prior.
+func ModelFromClient(serviceID string, sf client.SecretFile, prior Model) Model {
+	m := Model{
+		ID:               types.StringValue(serviceID + "/" + sf.Name),
+		ServiceID:        types.StringValue(serviceID),
+		Name:             types.StringValue(sf.Name),
+		Content:          types.StringValue(sf.Content),
+		ContentWO:        types.StringNull(),
+		ContentWOVersion: prior.ContentWOVersion,
+	}
+
+	if prior.SecretFile().IsWriteOnly() {
+		m.Content = types.StringNull()
+	}
+
+	return m
+}
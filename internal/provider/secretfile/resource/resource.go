@@ -0,0 +1,148 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/secretfile"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+var (
+	_ resource.Resource                = &secretFileResource{}
+	_ resource.ResourceWithConfigure   = &secretFileResource{}
+	_ resource.ResourceWithImportState = &secretFileResource{}
+)
+
+func NewSecretFileResource() resource.Resource {
+	return &secretFileResource{}
+}
+
+type secretFileResource struct {
+	client *client.ClientWithResponses
+}
+
+func (r *secretFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := rendertypes.ConfigureResource(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *secretFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_file"
+}
+
+func (r *secretFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+func (r *secretFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan secretfile.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.upsert(ctx, req.Config, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating secret file", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *secretFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state secretfile.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sf, err := common.GetServiceSecretFile(ctx, r.client, state.ServiceID.ValueString(), state.Name.ValueString())
+	if common.IsNotFoundErr(err) {
+		common.EmitNotFoundWarning(state.ID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading secret file", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, secretfile.ModelFromClient(state.ServiceID.ValueString(), *sf, state))...)
+}
+
+func (r *secretFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan secretfile.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.upsert(ctx, req.Config, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating secret file", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *secretFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state secretfile.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := common.DeleteServiceSecretFile(ctx, r.client, state.ServiceID.ValueString(), state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting secret file", err.Error())
+		return
+	}
+}
+
+// ImportState imports an secret file from an ID of the form <service_id>/<name>.
+func (r *secretFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, name, err := common.ParseServiceChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// upsert writes the planned content, reading content_wo from the config since it
+// is never part of the plan.
+func (r *secretFileResource) upsert(ctx context.Context, config tfsdk.Config, plan secretfile.Model, diags *diag.Diagnostics) (secretfile.Model, error) {
+	contentWO, d := common.WriteOnlyString(ctx, config, path.Root("content_wo"))
+	diags.Append(d...)
+	if diags.HasError() {
+		return plan, nil
+	}
+	plan.ContentWO = contentWO
+
+	sf, err := common.UpsertServiceSecretFile(ctx, r.client, plan.ServiceID.ValueString(), plan.Name.ValueString(), plan.SecretFile().ContentValue())
+	if err != nil {
+		return plan, err
+	}
+
+	return secretfile.ModelFromClient(plan.ServiceID.ValueString(), *sf, plan), nil
+}
@@ -0,0 +1,79 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"terraform-provider-render/internal/provider"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+const providerCfg = `
+provider "render" {
+  api_key = "some-api-key"
+  owner_id = "some-owner-id"
+}
+`
+
+func secretFileConfig(content string) string {
+	return providerCfg + fmt.Sprintf(`
+resource "render_secret_file" "test" {
+  service_id = "srv-1"
+  name       = "config.json"
+  content    = %q
+}
+`, content)
+}
+
+func TestSecretFileResource(t *testing.T) {
+	env := th.NewFakeEnv()
+	env.SetSecretFile("other.txt", "kept")
+	fakeServer := th.NewMockRenderAPI(env.Handlers("/services/srv-1"))
+	resourceName := "render_secret_file.test"
+	updated := `{"debug":true}`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(fakeServer.URL))()),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			env.CheckNoSecretFile("config.json"),
+			env.CheckSecretFile("other.txt", "kept"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: secretFileConfig(`{"debug":false}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "srv-1/config.json"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "srv-1"),
+					resource.TestCheckResourceAttr(resourceName, "name", "config.json"),
+					resource.TestCheckResourceAttr(resourceName, "content", `{"debug":false}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "srv-1/config.json",
+				ImportStateVerify: true,
+			},
+			{
+				Config: secretFileConfig(updated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content", updated),
+					env.CheckSecretFile("config.json", updated),
+					env.CheckSecretFile("other.txt", "kept"),
+				),
+			},
+		},
+	})
+}
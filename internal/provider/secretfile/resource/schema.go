@@ -0,0 +1,49 @@
+package resource
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-render/internal/provider/common/validators"
+	"terraform-provider-render/internal/provider/types/resource"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unique identifier for this secret file, of the form <service_id>/<name>.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_id": schema.StringAttribute{
+			Required:    true,
+			Description: "ID of the service to add the secret file to.",
+			Validators:  []validator.String{validators.StringNotEmpty},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Path of the secret file.",
+			Validators:  []validator.String{validators.StringNotEmpty},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+	maps.Copy(attributes, resource.SecretFile.Attributes)
+
+	return schema.Schema{
+		Description:         "Provides a single secret file of a Render service. Other secret files of the service are left alone, so a file can be owned by a different module or workspace than the service. Set ignore_unmanaged_env_vars on the service resource so that it does not remove the file.",
+		MarkdownDescription: "Provides a single secret file of a Render service. Other secret files of the service are left alone, so a file can be owned by a different module or workspace than the service. Set `ignore_unmanaged_env_vars` on the service resource so that it does not remove the file.",
+		Attributes:          attributes,
+	}
+}
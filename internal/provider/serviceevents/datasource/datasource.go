@@ -0,0 +1,97 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/serviceevents"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serviceEventsDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceEventsDataSource{}
+)
+
+// NewServiceEventsDataSource is a helper function to simplify the provider implementation.
+func NewServiceEventsDataSource() datasource.DataSource {
+	return &serviceEventsDataSource{}
+}
+
+// serviceEventsDataSource is the data source implementation.
+type serviceEventsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serviceEventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := rendertypes.ConfigureDatasource(req, resp)
+	if data == nil {
+		return
+	}
+
+	d.client = data.Client
+}
+
+// Metadata returns the data source type name.
+func (d *serviceEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_events"
+}
+
+// Schema defines the schema for the data source.
+func (d *serviceEventsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serviceEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serviceevents.ServiceEventsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime, err := common.TimeFromString(config.StartTime)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid start time", err.Error())
+	}
+
+	endTime, err := common.TimeFromString(config.EndTime)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid end time", err.Error())
+	}
+
+	var eventTypes []string
+	resp.Diagnostics.Append(config.Types.ElementsAs(ctx, &eventTypes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	evs, err := serviceevents.ListServiceEvents(ctx, d.client, serviceevents.ListParams{
+		ServiceID:  config.ServiceID.ValueString(),
+		EventTypes: eventTypes,
+		StartTime:  startTime,
+		EndTime:    endTime,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list service events", err.Error())
+		return
+	}
+
+	config.Events = make([]serviceevents.EventModel, 0, len(evs))
+	for _, ev := range evs {
+		model, err := serviceevents.EventModelFromClient(ev)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read service event", err.Error())
+			return
+		}
+		config.Events = append(config.Events, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,117 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/provider/common/validators"
+	"terraform-provider-render/internal/provider/serviceevents"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Provides the events of a Render service, such as deploys, autoscaling and server failures, within a time window.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the service to read events for.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+			},
+			"types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return events of these types. All event types are returned when omitted.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(serviceevents.ServiceEventTypes...)),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start of the time window as an RFC 3339 timestamp. Defaults to one hour before end_time.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"end_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the time window as an RFC 3339 timestamp. Defaults to now.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"events": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Events in the time window, newest first. Attributes that don't apply to an event's type are null.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the event.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the event, e.g. deploy_ended or server_failed.",
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the event occurred.",
+						},
+						"build_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Build the event belongs to. Set for build and pipeline minutes events.",
+						},
+						"deploy_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Deploy the event belongs to. Set for deploy, pre-deploy and initial deploy hook events.",
+						},
+						"job_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Job or cron job run the event belongs to.",
+						},
+						"instance_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Instance the event happened on. Set for server_failed events.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Outcome of a build, deploy, pre-deploy or job, e.g. succeeded, failed or canceled.",
+						},
+						"trigger": schema.StringAttribute{
+							Computed:    true,
+							Description: "What started a build or deploy. One of first_build, rollback, new_commit, env_updated, clear_cache, manual, deployed_by_render, updated_property or other.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:    true,
+							Description: "Why a build, deploy, job or server ended. One of oom_killed, evicted, non_zero_exit, timed_out, unhealthy, early_exit, build_failed, new_build or new_deploy.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human readable detail for the reason, such as the exit code or health check failure.",
+						},
+						"from_instances": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Instance count before an autoscaling or instance count change.",
+						},
+						"to_instances": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Instance count after an autoscaling or instance count change.",
+						},
+						"from": schema.StringAttribute{
+							Computed:    true,
+							Description: "Previous value for change events such as plan_changed, disk_updated, branch_deleted and maintenance_mode_uri_updated.",
+						},
+						"to": schema.StringAttribute{
+							Computed:    true,
+							Description: "New value for change events. For image_pull_failed events, the image that could not be pulled.",
+						},
+						"details": schema.StringAttribute{
+							Computed:    true,
+							Description: "The full event details as returned by the API, JSON encoded. Use jsondecode() to read fields that aren't flattened.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package serviceevents
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/client/events"
+	"terraform-provider-render/internal/client/eventtypes"
+	"terraform-provider-render/internal/provider/common"
+)
+
+const pageSize = 100
+
+type eventWithCursor struct {
+	Cursor *client.Cursor       `json:"cursor,omitempty"`
+	Event  *events.ServiceEvent `json:"event,omitempty"`
+}
+
+type ListParams struct {
+	ServiceID  string
+	EventTypes []string
+	StartTime  *time.Time
+	EndTime    *time.Time
+}
+
+// ListServiceEvents returns every event in the time window, newest first. The API
+// filters on a single event type, so multiple types are fetched one at a time.
+func ListServiceEvents(ctx context.Context, apiClient *client.ClientWithResponses, params ListParams) ([]events.ServiceEvent, error) {
+	if len(params.EventTypes) == 0 {
+		return listEventsOfType(ctx, apiClient, params, nil)
+	}
+
+	var res []events.ServiceEvent
+	for _, eventType := range params.EventTypes {
+		typeParam := &client.EventTypeParam{}
+		if err := typeParam.FromExternalRef7ServiceEventType(eventtypes.ServiceEventType(eventType)); err != nil {
+			return nil, err
+		}
+
+		evs, err := listEventsOfType(ctx, apiClient, params, typeParam)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, evs...)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Timestamp.After(res[j].Timestamp)
+	})
+
+	return res, nil
+}
+
+func listEventsOfType(ctx context.Context, apiClient *client.ClientWithResponses, params ListParams, eventType *client.EventTypeParam) ([]events.ServiceEvent, error) {
+	var res []events.ServiceEvent
+	var cursor *string
+
+	for {
+		var page []eventWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListEvents(ctx, params.ServiceID, &client.ListEventsParams{
+				Type:      eventType,
+				StartTime: params.StartTime,
+				EndTime:   params.EndTime,
+				Cursor:    cursor,
+				Limit:     common.From(pageSize),
+			})
+		}, &page)
+		if err != nil {
+			return nil, fmt.Errorf("could not list events for service: %w", err)
+		}
+
+		for _, ev := range page {
+			if ev.Event != nil {
+				res = append(res, *ev.Event)
+			}
+		}
+
+		if len(page) < pageSize || page[len(page)-1].Cursor == nil {
+			break
+		}
+		cursor = page[len(page)-1].Cursor
+	}
+
+	return res, nil
+}
@@ -0,0 +1,109 @@
+package serviceevents_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/serviceevents"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func TestListServiceEvents(t *testing.T) {
+	t.Run("it requests each event type and sorts newest first", func(t *testing.T) {
+		var requestedTypes []string
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-123/events": func(resp http.ResponseWriter, req *http.Request) {
+				eventType := req.URL.Query().Get("type")
+				requestedTypes = append(requestedTypes, eventType)
+
+				switch eventType {
+				case "deploy_ended":
+					th.StaticResponse(`[{"cursor": "c1", "event": {
+						"id": "evt-1", "serviceId": "srv-123", "type": "deploy_ended", "timestamp": "2024-01-01T10:00:00Z",
+						"details": {"deployId": "dep-1", "deployStatus": "failed", "status": 3, "reason": {"failure": {"evicted": false, "nonZeroExit": 1}}}
+					}}]`)(resp, req)
+				case "server_failed":
+					th.StaticResponse(`[{"cursor": "c2", "event": {
+						"id": "evt-2", "serviceId": "srv-123", "type": "server_failed", "timestamp": "2024-01-01T11:00:00Z",
+						"details": {"instanceID": "srv-123-abcde", "reason": {"evicted": false, "oomKilled": {"memoryLimit": "512Mi"}}}
+					}}]`)(resp, req)
+				default:
+					th.StaticResponse(`[]`)(resp, req)
+				}
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		evs, err := serviceevents.ListServiceEvents(context.Background(), c, serviceevents.ListParams{
+			ServiceID:  "srv-123",
+			EventTypes: []string{"deploy_ended", "server_failed"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"deploy_ended", "server_failed"}, requestedTypes)
+		require.Len(t, evs, 2)
+		assert.Equal(t, "evt-2", evs[0].Id)
+		assert.Equal(t, "evt-1", evs[1].Id)
+
+		serverFailed, err := serviceevents.EventModelFromClient(evs[0])
+		require.NoError(t, err)
+		assert.Equal(t, "srv-123-abcde", serverFailed.InstanceID.ValueString())
+		assert.Equal(t, "oom_killed", serverFailed.Reason.ValueString())
+		assert.Equal(t, "memory limit 512Mi", serverFailed.Message.ValueString())
+		assert.True(t, serverFailed.DeployID.IsNull())
+
+		deployEnded, err := serviceevents.EventModelFromClient(evs[1])
+		require.NoError(t, err)
+		assert.Equal(t, "dep-1", deployEnded.DeployID.ValueString())
+		assert.Equal(t, "failed", deployEnded.Status.ValueString())
+		assert.Equal(t, "non_zero_exit", deployEnded.Reason.ValueString())
+		assert.Equal(t, "2024-01-01T10:00:00Z", deployEnded.Timestamp.ValueString())
+	})
+
+	t.Run("it pages through results with the cursor", func(t *testing.T) {
+		var cursors []string
+		firstPage := make([]map[string]any, 100)
+		for i := range firstPage {
+			firstPage[i] = map[string]any{
+				"cursor": "page-1",
+				"event": map[string]any{
+					"id": "evt", "serviceId": "srv-123", "type": "server_available", "timestamp": "2024-01-01T10:00:00Z", "details": map[string]any{},
+				},
+			}
+		}
+
+		mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+			"/services/srv-123/events": func(resp http.ResponseWriter, req *http.Request) {
+				cursors = append(cursors, req.URL.Query().Get("cursor"))
+				assert.Equal(t, "2024-01-01T09:00:00Z", req.URL.Query().Get("startTime"))
+
+				if req.URL.Query().Get("cursor") == "" {
+					th.StaticResponse(firstPage)(resp, req)
+					return
+				}
+				th.StaticResponse(`[]`)(resp, req)
+			},
+		})
+
+		c, err := client.NewClientWithResponses(mockAPI.URL)
+		require.NoError(t, err)
+
+		evs, err := serviceevents.ListServiceEvents(context.Background(), c, serviceevents.ListParams{
+			ServiceID: "srv-123",
+			StartTime: common.From(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)),
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, evs, 100)
+		assert.Equal(t, []string{"", "page-1"}, cursors)
+	})
+}
@@ -0,0 +1,335 @@
+package serviceevents
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client/events"
+	"terraform-provider-render/internal/client/eventtypes"
+	"terraform-provider-render/internal/provider/common"
+)
+
+type ServiceEventsModel struct {
+	ServiceID types.String `tfsdk:"service_id"`
+	Types     types.Set    `tfsdk:"types"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+	Events    []EventModel `tfsdk:"events"`
+}
+
+// EventModel is a flattened service event. Fields that don't apply to an
+// event's type are null; the full payload is always available in details.
+type EventModel struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	Timestamp     types.String `tfsdk:"timestamp"`
+	BuildID       types.String `tfsdk:"build_id"`
+	DeployID      types.String `tfsdk:"deploy_id"`
+	JobID         types.String `tfsdk:"job_id"`
+	InstanceID    types.String `tfsdk:"instance_id"`
+	Status        types.String `tfsdk:"status"`
+	Trigger       types.String `tfsdk:"trigger"`
+	Reason        types.String `tfsdk:"reason"`
+	Message       types.String `tfsdk:"message"`
+	FromInstances types.Int64  `tfsdk:"from_instances"`
+	ToInstances   types.Int64  `tfsdk:"to_instances"`
+	From          types.String `tfsdk:"from"`
+	To            types.String `tfsdk:"to"`
+	Details       types.String `tfsdk:"details"`
+}
+
+// ServiceEventTypes lists every event type that can be used to filter service events.
+var ServiceEventTypes = []string{
+	string(eventtypes.ServiceEventTypeAutoscalingConfigChanged),
+	string(eventtypes.ServiceEventTypeAutoscalingEnded),
+	string(eventtypes.ServiceEventTypeAutoscalingStarted),
+	string(eventtypes.ServiceEventTypeBranchDeleted),
+	string(eventtypes.ServiceEventTypeBuildEnded),
+	string(eventtypes.ServiceEventTypeBuildStarted),
+	string(eventtypes.ServiceEventTypeCommitIgnored),
+	string(eventtypes.ServiceEventTypeCronJobRunEnded),
+	string(eventtypes.ServiceEventTypeCronJobRunStarted),
+	string(eventtypes.ServiceEventTypeDeployEnded),
+	string(eventtypes.ServiceEventTypeDeployStarted),
+	string(eventtypes.ServiceEventTypeDiskCreated),
+	string(eventtypes.ServiceEventTypeDiskDeleted),
+	string(eventtypes.ServiceEventTypeDiskUpdated),
+	string(eventtypes.ServiceEventTypeImagePullFailed),
+	string(eventtypes.ServiceEventTypeInitialDeployHookEnded),
+	string(eventtypes.ServiceEventTypeInitialDeployHookStarted),
+	string(eventtypes.ServiceEventTypeInstanceCountChanged),
+	string(eventtypes.ServiceEventTypeJobRunEnded),
+	string(eventtypes.ServiceEventTypeMaintenanceEnded),
+	string(eventtypes.ServiceEventTypeMaintenanceModeEnabled),
+	string(eventtypes.ServiceEventTypeMaintenanceModeUriUpdated),
+	string(eventtypes.ServiceEventTypeMaintenanceStarted),
+	string(eventtypes.ServiceEventTypePipelineMinutesExhausted),
+	string(eventtypes.ServiceEventTypePlanChanged),
+	string(eventtypes.ServiceEventTypePreDeployEnded),
+	string(eventtypes.ServiceEventTypePreDeployStarted),
+	string(eventtypes.ServiceEventTypeServerAvailable),
+	string(eventtypes.ServiceEventTypeServerFailed),
+	string(eventtypes.ServiceEventTypeServerHardwareFailure),
+	string(eventtypes.ServiceEventTypeServerRestarted),
+	string(eventtypes.ServiceEventTypeServiceResumed),
+	string(eventtypes.ServiceEventTypeServiceSuspended),
+	string(eventtypes.ServiceEventTypeSuspenderAdded),
+	string(eventtypes.ServiceEventTypeSuspenderRemoved),
+	string(eventtypes.ServiceEventTypeZeroDowntimeRedeployEnded),
+	string(eventtypes.ServiceEventTypeZeroDowntimeRedeployStarted),
+}
+
+func EventModelFromClient(ev events.ServiceEvent) (EventModel, error) {
+	details, err := ev.Details.MarshalJSON()
+	if err != nil {
+		return EventModel{}, fmt.Errorf("could not read details of event %s: %w", ev.Id, err)
+	}
+
+	m := EventModel{
+		ID:            types.StringValue(ev.Id),
+		Type:          types.StringValue(string(ev.Type)),
+		Timestamp:     common.StringFromTime(ev.Timestamp),
+		BuildID:       types.StringNull(),
+		DeployID:      types.StringNull(),
+		JobID:         types.StringNull(),
+		InstanceID:    types.StringNull(),
+		Status:        types.StringNull(),
+		Trigger:       types.StringNull(),
+		Reason:        types.StringNull(),
+		Message:       types.StringNull(),
+		FromInstances: types.Int64Null(),
+		ToInstances:   types.Int64Null(),
+		From:          types.StringNull(),
+		To:            types.StringNull(),
+		Details:       types.StringValue(string(details)),
+	}
+
+	if err := flattenDetails(ev.Type, ev.Details, &m); err != nil {
+		return EventModel{}, fmt.Errorf("could not read details of %s event %s: %w", ev.Type, ev.Id, err)
+	}
+
+	return m, nil
+}
+
+func flattenDetails(eventType eventtypes.ServiceEventType, d events.ServiceEventDetails, m *EventModel) error {
+	switch eventType {
+	case eventtypes.ServiceEventTypeAutoscalingStarted:
+		details, err := d.AsAutoscalingStartedEvent()
+		if err != nil {
+			return err
+		}
+		m.FromInstances = types.Int64Value(int64(details.FromInstances))
+		m.ToInstances = types.Int64Value(int64(details.ToInstances))
+	case eventtypes.ServiceEventTypeAutoscalingEnded:
+		details, err := d.AsAutoscalingEndedEvent()
+		if err != nil {
+			return err
+		}
+		m.FromInstances = types.Int64Value(int64(details.FromInstances))
+		m.ToInstances = types.Int64Value(int64(details.ToInstances))
+	case eventtypes.ServiceEventTypeInstanceCountChanged:
+		details, err := d.AsInstanceCountChangedEvent()
+		if err != nil {
+			return err
+		}
+		m.FromInstances = types.Int64Value(int64(details.FromInstances))
+		m.ToInstances = types.Int64Value(int64(details.ToInstances))
+	case eventtypes.ServiceEventTypeBranchDeleted:
+		details, err := d.AsBranchDeletedEvent()
+		if err != nil {
+			return err
+		}
+		m.From = types.StringValue(details.DeletedBranch)
+		m.To = types.StringValue(details.NewBranch)
+	case eventtypes.ServiceEventTypeBuildStarted:
+		details, err := d.AsBuildStartedEvent()
+		if err != nil {
+			return err
+		}
+		m.BuildID = types.StringValue(details.BuildId)
+		m.Trigger = types.StringValue(triggerFromClient(details.Trigger))
+	case eventtypes.ServiceEventTypeBuildEnded:
+		details, err := d.AsBuildEndedEvent()
+		if err != nil {
+			return err
+		}
+		m.BuildID = types.StringValue(details.BuildId)
+		m.Status = types.StringValue(string(details.BuildStatus))
+		setEndReason(details.Reason, m)
+	case eventtypes.ServiceEventTypePipelineMinutesExhausted:
+		details, err := d.AsPipelineMinutesExhaustedEvent()
+		if err != nil {
+			return err
+		}
+		m.BuildID = types.StringValue(details.BuildId)
+		m.Trigger = types.StringValue(triggerFromClient(details.Trigger))
+	case eventtypes.ServiceEventTypeDeployStarted:
+		details, err := d.AsDeployStartedEvent()
+		if err != nil {
+			return err
+		}
+		m.DeployID = types.StringValue(details.DeployId)
+		m.Trigger = types.StringValue(triggerFromClient(details.Trigger))
+	case eventtypes.ServiceEventTypeDeployEnded:
+		details, err := d.AsDeployEndedEvent()
+		if err != nil {
+			return err
+		}
+		m.DeployID = types.StringValue(details.DeployId)
+		m.Status = types.StringValue(string(details.DeployStatus))
+		setEndReason(details.Reason, m)
+	case eventtypes.ServiceEventTypePreDeployStarted:
+		details, err := d.AsPreDeployStartedEvent()
+		if err != nil {
+			return err
+		}
+		m.DeployID = types.StringValue(details.DeployId)
+	case eventtypes.ServiceEventTypePreDeployEnded:
+		details, err := d.AsPreDeployEndedEvent()
+		if err != nil {
+			return err
+		}
+		m.DeployID = types.StringValue(details.DeployId)
+		m.Status = types.StringValue(string(details.PreDeployStatus))
+		setEndReason(details.Reason, m)
+	case eventtypes.ServiceEventTypeInitialDeployHookStarted:
+		details, err := d.AsInitialDeployHookStartedEvent()
+		if err != nil {
+			return err
+		}
+		m.DeployID = types.StringValue(details.DeployId)
+	case eventtypes.ServiceEventTypeInitialDeployHookEnded:
+		details, err := d.AsInitialDeployHookEndedEvent()
+		if err != nil {
+			return err
+		}
+		m.DeployID = types.StringValue(details.DeployId)
+	case eventtypes.ServiceEventTypeCronJobRunStarted:
+		details, err := d.AsCronJobRunStartedEvent()
+		if err != nil {
+			return err
+		}
+		m.JobID = types.StringValue(details.CronJobRunId)
+	case eventtypes.ServiceEventTypeCronJobRunEnded:
+		details, err := d.AsCronJobRunEndedEvent()
+		if err != nil {
+			return err
+		}
+		m.JobID = types.StringValue(details.CronJobRunId)
+		m.Status = types.StringValue(string(details.Status))
+		setFailureReason(details.Reason, m)
+	case eventtypes.ServiceEventTypeJobRunEnded:
+		details, err := d.AsJobRunEndedEvent()
+		if err != nil {
+			return err
+		}
+		m.JobID = types.StringValue(details.JobId)
+		m.Status = types.StringValue(string(details.Status))
+		setFailureReason(details.Reason, m)
+	case eventtypes.ServiceEventTypeServerFailed:
+		details, err := d.AsServerFailedEvent()
+		if err != nil {
+			return err
+		}
+		m.InstanceID = types.StringPointerValue(details.InstanceID)
+		setFailureReason(details.Reason, m)
+	case eventtypes.ServiceEventTypeImagePullFailed:
+		details, err := d.AsImagePullFailedEvent()
+		if err != nil {
+			return err
+		}
+		m.Message = types.StringValue(details.Message)
+		m.To = types.StringValue(details.ImageURL)
+	case eventtypes.ServiceEventTypePlanChanged:
+		details, err := d.AsPlanChangedEvent()
+		if err != nil {
+			return err
+		}
+		m.From = types.StringValue(details.From)
+		m.To = types.StringValue(details.To)
+	case eventtypes.ServiceEventTypeDiskUpdated:
+		details, err := d.AsDiskUpdatedEvent()
+		if err != nil {
+			return err
+		}
+		m.From = types.StringValue(fmt.Sprintf("%d", details.FromSizeGB))
+		m.To = types.StringValue(fmt.Sprintf("%d", details.ToSizeGB))
+	case eventtypes.ServiceEventTypeMaintenanceModeUriUpdated:
+		details, err := d.AsMaintenanceModeURIUpdatedEvent()
+		if err != nil {
+			return err
+		}
+		m.From = types.StringValue(details.FromURI)
+		m.To = types.StringValue(details.ToURI)
+	case eventtypes.ServiceEventTypeZeroDowntimeRedeployStarted:
+		details, err := d.AsZeroDowntimeRedeployStartedEvent()
+		if err != nil {
+			return err
+		}
+		m.Trigger = types.StringValue(details.Trigger)
+	}
+
+	return nil
+}
+
+// triggerFromClient reduces a build or deploy trigger to a single keyword.
+func triggerFromClient(t events.BuildDeployTrigger) string {
+	switch {
+	case t.FirstBuild:
+		return "first_build"
+	case t.Rollback:
+		return "rollback"
+	case t.NewCommit != nil:
+		return "new_commit"
+	case t.EnvUpdated:
+		return "env_updated"
+	case t.ClearCache:
+		return "clear_cache"
+	case t.Manual:
+		return "manual"
+	case t.DeployedByRender:
+		return "deployed_by_render"
+	case t.UpdatedProperty != nil:
+		return "updated_property"
+	}
+	return "other"
+}
+
+func setEndReason(r events.BuildDeployEndReason, m *EventModel) {
+	switch {
+	case r.Failure != nil:
+		setFailureReason(r.Failure, m)
+	case r.BuildFailed != nil:
+		m.Reason = types.StringValue("build_failed")
+	case r.NewBuild != nil:
+		m.Reason = types.StringValue("new_build")
+	case r.NewDeploy != nil:
+		m.Reason = types.StringValue("new_deploy")
+	}
+}
+
+func setFailureReason(r *events.FailureReason, m *EventModel) {
+	if r == nil {
+		return
+	}
+
+	switch {
+	case r.OomKilled != nil:
+		m.Reason = types.StringValue("oom_killed")
+		m.Message = types.StringValue("memory limit " + r.OomKilled.MemoryLimit)
+	case r.Evicted:
+		m.Reason = types.StringValue("evicted")
+	case r.NonZeroExit != nil:
+		m.Reason = types.StringValue("non_zero_exit")
+		m.Message = types.StringValue(fmt.Sprintf("exit code %d", *r.NonZeroExit))
+	case r.TimedOutReason != nil || r.TimedOutSeconds != nil:
+		m.Reason = types.StringValue("timed_out")
+		m.Message = types.StringPointerValue(r.TimedOutReason)
+	case r.Unhealthy != nil:
+		m.Reason = types.StringValue("unhealthy")
+		m.Message = types.StringValue(*r.Unhealthy)
+	case r.EarlyExit != nil && *r.EarlyExit:
+		m.Reason = types.StringValue("early_exit")
+	}
+}
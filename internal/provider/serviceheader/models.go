@@ -0,0 +1,71 @@
+package serviceheader
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+const pageSize = 100
+
+// Model is the Terraform-side representation of a single response header rule.
+type Model struct {
+	ID        types.String `tfsdk:"id"`
+	ServiceID types.String `tfsdk:"service_id"`
+	Path      types.String `tfsdk:"path"`
+	Name      types.String `tfsdk:"name"`
+	Value     types.String `tfsdk:"value"`
+}
+
+func ModelFromClient(serviceID string, header client.Header) Model {
+	return Model{
+		ID:        types.StringValue(header.Id),
+		ServiceID: types.StringValue(serviceID),
+		Path:      types.StringValue(header.Path),
+		Name:      types.StringValue(header.Name),
+		Value:     types.StringValue(header.Value),
+	}
+}
+
+// ListHeaders returns every header rule of a service.
+func ListHeaders(ctx context.Context, apiClient *client.ClientWithResponses, serviceID string) ([]client.Header, error) {
+	limit := pageSize
+	var cursor *string
+	var res []client.Header
+
+	for {
+		var page []client.HeaderWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListHeaders(ctx, serviceID, &client.ListHeadersParams{
+				Cursor: cursor,
+				Limit:  &limit,
+			})
+		}, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range page {
+			res = append(res, h.Header)
+		}
+
+		if len(page) < limit {
+			return res, nil
+		}
+		cursor = &page[len(page)-1].Cursor
+	}
+}
+
+// FindHeader returns the first header matching the predicate, or nil if there is none.
+func FindHeader(headers []client.Header, match func(client.Header) bool) *client.Header {
+	for i := range headers {
+		if match(headers[i]) {
+			return &headers[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/serviceheader"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+var (
+	_ resource.Resource                = &serviceHeaderResource{}
+	_ resource.ResourceWithConfigure   = &serviceHeaderResource{}
+	_ resource.ResourceWithImportState = &serviceHeaderResource{}
+)
+
+func NewServiceHeaderResource() resource.Resource {
+	return &serviceHeaderResource{}
+}
+
+type serviceHeaderResource struct {
+	client *client.ClientWithResponses
+}
+
+func (r *serviceHeaderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := rendertypes.ConfigureResource(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *serviceHeaderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_header"
+}
+
+func (r *serviceHeaderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+func (r *serviceHeaderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceheader.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+	body := client.HeaderInput{
+		Path:  plan.Path.ValueString(),
+		Name:  plan.Name.ValueString(),
+		Value: plan.Value.ValueString(),
+	}
+
+	var created struct {
+		Headers *client.Header `json:"headers,omitempty"`
+	}
+	if err := common.Create(func() (*http.Response, error) {
+		return r.client.AddHeaders(ctx, serviceID, body)
+	}, &created); err != nil {
+		resp.Diagnostics.AddError("Error creating header", err.Error())
+		return
+	}
+
+	header := created.Headers
+	if header == nil {
+		headers, err := serviceheader.ListHeaders(ctx, r.client, serviceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating header", err.Error())
+			return
+		}
+		header = serviceheader.FindHeader(headers, matchInput(body))
+		if header == nil {
+			resp.Diagnostics.AddError("Error creating header", "header was not found after it was added")
+			return
+		}
+	}
+
+	state := serviceheader.ModelFromClient(serviceID, *header)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *serviceHeaderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceheader.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers, err := serviceheader.ListHeaders(ctx, r.client, state.ServiceID.ValueString())
+	if common.IsNotFoundErr(err) {
+		common.EmitNotFoundWarning(state.ID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading header", err.Error())
+		return
+	}
+
+	header := serviceheader.FindHeader(headers, func(h client.Header) bool {
+		return h.Id == state.ID.ValueString()
+	})
+	if header == nil {
+		common.EmitNotFoundWarning(state.ID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := serviceheader.ModelFromClient(state.ServiceID.ValueString(), *header)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// Update is never called: every attribute requires replacement, so a changed
+// header is deleted and added again without touching the other headers of the
+// service.
+func (r *serviceHeaderResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Error updating header", "headers cannot be updated in place")
+}
+
+func (r *serviceHeaderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceheader.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := common.Delete(func() (*http.Response, error) {
+		return r.client.DeleteHeader(ctx, state.ServiceID.ValueString(), state.ID.ValueString())
+	}); err != nil {
+		resp.Diagnostics.AddError("Error deleting header", err.Error())
+		return
+	}
+}
+
+// ImportState imports a header from an ID of the form <service_id>/<header_id>.
+func (r *serviceHeaderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, headerID, err := common.ParseServiceChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), headerID)...)
+}
+
+func matchInput(input client.HeaderInput) func(client.Header) bool {
+	return func(h client.Header) bool {
+		return h.Path == input.Path && h.Name == input.Name && h.Value == input.Value
+	}
+}
@@ -0,0 +1,166 @@
+package resource_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+const providerCfg = `
+provider "render" {
+  api_key = "some-api-key"
+  owner_id = "some-owner-id"
+}
+`
+
+// headerServer fakes the header endpoints of a static site that already has
+// one header that Terraform does not manage.
+type headerServer struct {
+	mu      sync.Mutex
+	headers []client.Header
+	nextID  int
+}
+
+func newHeaderServer(t *testing.T) *httptest.Server {
+	s := &headerServer{
+		headers: []client.Header{{Id: "hdr-other", Path: "/*", Name: "Cache-Control", Value: "no-cache"}},
+	}
+
+	return th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/services/srv-1/headers": func(resp http.ResponseWriter, req *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			switch req.Method {
+			case http.MethodGet:
+				page := []client.HeaderWithCursor{}
+				for _, h := range s.headers {
+					page = append(page, client.HeaderWithCursor{Cursor: h.Id, Header: h})
+				}
+				th.StaticResponse(page)(resp, req)
+			case http.MethodPost:
+				var input client.HeaderInput
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&input))
+
+				s.nextID++
+				header := client.Header{Id: fmt.Sprintf("hdr-%d", s.nextID), Path: input.Path, Name: input.Name, Value: input.Value}
+				s.headers = append(s.headers, header)
+
+				resp.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(resp).Encode(map[string]client.Header{"headers": header})
+			default:
+				t.Errorf("unexpected %s to the headers of the service", req.Method)
+				resp.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		},
+		"/services/srv-1/headers/.+": func(resp http.ResponseWriter, req *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			require.Equal(t, http.MethodDelete, req.Method)
+			id := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+			for i, h := range s.headers {
+				if h.Id == id {
+					s.headers = append(s.headers[:i], s.headers[i+1:]...)
+					resp.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+			resp.WriteHeader(http.StatusNotFound)
+		},
+	})
+}
+
+// checkOtherHeaderKept checks that the header Terraform does not manage still
+// exists with its original ID.
+func checkOtherHeaderKept(fakeServer *httptest.Server) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		resp, err := http.Get(fakeServer.URL + "/services/srv-1/headers")
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		var headers []client.HeaderWithCursor
+		if err := json.NewDecoder(resp.Body).Decode(&headers); err != nil {
+			return err
+		}
+		for _, h := range headers {
+			if h.Header.Id == "hdr-other" {
+				return nil
+			}
+		}
+		return fmt.Errorf("header hdr-other was removed, got %v", headers)
+	}
+}
+
+func headerConfig(value string) string {
+	return providerCfg + fmt.Sprintf(`
+resource "render_service_header" "test" {
+  service_id = "srv-1"
+  path       = "/*"
+  name       = "X-Frame-Options"
+  value      = %q
+}
+`, value)
+}
+
+func TestServiceHeaderResource(t *testing.T) {
+	fakeServer := newHeaderServer(t)
+	resourceName := "render_service_header.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(fakeServer.URL))()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: headerConfig("DENY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "hdr-1"),
+					resource.TestCheckResourceAttr(resourceName, "service_id", "srv-1"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/*"),
+					resource.TestCheckResourceAttr(resourceName, "name", "X-Frame-Options"),
+					resource.TestCheckResourceAttr(resourceName, "value", "DENY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return rs.Primary.Attributes["service_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: headerConfig("SAMEORIGIN"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "hdr-2"),
+					resource.TestCheckResourceAttr(resourceName, "value", "SAMEORIGIN"),
+					checkOtherHeaderKept(fakeServer),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,60 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-render/internal/provider/common/validators"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides a single response header rule for a Render static site. Use this instead of the headers attribute of render_static_site when rules are owned by different modules.",
+		MarkdownDescription: "Provides a single [response header rule](https://render.com/docs/static-site-headers) for a Render static site. Use this instead of the `headers` attribute of `render_static_site` when rules are owned by different modules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for this header rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the static site to add the header to.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Request paths to apply the header to. Wildcards apply the header to all matching paths.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the header.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Description: "Value of the header.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package servicepreview
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+)
+
+// Model is the Terraform-side representation of an image preview instance.
+type Model struct {
+	ID           types.String `tfsdk:"id"`
+	ServiceID    types.String `tfsdk:"service_id"`
+	ImagePath    types.String `tfsdk:"image_path"`
+	Name         types.String `tfsdk:"name"`
+	Plan         types.String `tfsdk:"plan"`
+	URL          types.String `tfsdk:"url"`
+	DashboardURL types.String `tfsdk:"dashboard_url"`
+}
+
+// ModelFromClient maps the preview service onto the model. The parent service
+// and image path are not part of the preview service, so they come from prior.
+func ModelFromClient(service *client.Service, prior Model) (Model, error) {
+	m := Model{
+		ID:           types.StringValue(service.Id),
+		ServiceID:    prior.ServiceID,
+		ImagePath:    prior.ImagePath,
+		Name:         types.StringValue(service.Name),
+		Plan:         types.StringNull(),
+		URL:          types.StringNull(),
+		DashboardURL: types.StringValue(service.DashboardUrl),
+	}
+
+	switch service.Type {
+	case client.WebService:
+		details, err := service.ServiceDetails.AsWebServiceDetails()
+		if err != nil {
+			return Model{}, err
+		}
+		m.Plan = types.StringValue(string(details.Plan))
+		m.URL = types.StringValue(details.Url)
+	case client.PrivateService:
+		details, err := service.ServiceDetails.AsPrivateServiceDetails()
+		if err != nil {
+			return Model{}, err
+		}
+		m.Plan = types.StringValue(string(details.Plan))
+		m.URL = types.StringValue(details.Url)
+	case client.BackgroundWorker:
+		details, err := service.ServiceDetails.AsBackgroundWorkerDetails()
+		if err != nil {
+			return Model{}, err
+		}
+		m.Plan = types.StringValue(string(details.Plan))
+	}
+
+	return m, nil
+}
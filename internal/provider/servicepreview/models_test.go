@@ -0,0 +1,38 @@
+package servicepreview_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/servicepreview"
+)
+
+func TestModelFromClient(t *testing.T) {
+	var service client.Service
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "srv-preview",
+		"name": "api-pr-12",
+		"type": "web_service",
+		"dashboardUrl": "https://dashboard.render.com/web/srv-preview",
+		"serviceDetails": {"plan": "starter", "url": "https://api-pr-12.onrender.com"}
+	}`), &service))
+
+	model, err := servicepreview.ModelFromClient(&service, servicepreview.Model{
+		ServiceID: types.StringValue("srv-parent"),
+		ImagePath: types.StringValue("ghcr.io/example/api:pr-12"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "srv-preview", model.ID.ValueString())
+	assert.Equal(t, "srv-parent", model.ServiceID.ValueString())
+	assert.Equal(t, "ghcr.io/example/api:pr-12", model.ImagePath.ValueString())
+	assert.Equal(t, "api-pr-12", model.Name.ValueString())
+	assert.Equal(t, "starter", model.Plan.ValueString())
+	assert.Equal(t, "https://api-pr-12.onrender.com", model.URL.ValueString())
+	assert.Equal(t, "https://dashboard.render.com/web/srv-preview", model.DashboardURL.ValueString())
+}
@@ -0,0 +1,158 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/servicepreview"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+var (
+	_ resource.Resource                = &servicePreviewResource{}
+	_ resource.ResourceWithConfigure   = &servicePreviewResource{}
+	_ resource.ResourceWithImportState = &servicePreviewResource{}
+)
+
+func NewServicePreviewResource() resource.Resource {
+	return &servicePreviewResource{}
+}
+
+type servicePreviewResource struct {
+	client                  *client.ClientWithResponses
+	poller                  *common.Poller
+	waitForDeployCompletion bool
+}
+
+func (r *servicePreviewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := rendertypes.ConfigureResource(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+	r.poller = data.Poller
+	r.waitForDeployCompletion = data.WaitForDeployCompletion
+}
+
+func (r *servicePreviewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_preview"
+}
+
+func (r *servicePreviewResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+func (r *servicePreviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan servicepreview.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := client.PreviewInput{
+		ImagePath: plan.ImagePath.ValueString(),
+	}
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		body.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.Plan.IsNull() && !plan.Plan.IsUnknown() {
+		body.Plan = common.From(client.Plan(plan.Plan.ValueString()))
+	}
+
+	var created client.ServiceAndDeploy
+	if err := common.Create(func() (*http.Response, error) {
+		return r.client.PreviewService(ctx, plan.ServiceID.ValueString(), body)
+	}, &created); err != nil {
+		resp.Diagnostics.AddError("Error creating service preview", err.Error())
+		return
+	}
+	if created.Service == nil {
+		resp.Diagnostics.AddError("Error creating service preview", "the response did not include the preview service")
+		return
+	}
+
+	state, err := servicepreview.ModelFromClient(created.Service, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating service preview", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+	if !r.waitForDeployCompletion {
+		return
+	}
+
+	if err := common.WaitForService(ctx, r.poller, r.client, created.Service, created.DeployId, common.DeployWaitUntilLive, common.DefaultServiceTimeout); err != nil {
+		resp.Diagnostics.AddError("Error creating service preview", "Preview never started: "+err.Error())
+		return
+	}
+}
+
+func (r *servicePreviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state servicepreview.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := common.GetService(ctx, r.client, state.ID.ValueString())
+	if common.IsNotFoundErr(err) {
+		common.EmitNotFoundWarning(state.ID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading service preview", err.Error())
+		return
+	}
+
+	newState, err := servicepreview.ModelFromClient(service, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading service preview", err.Error())
+		return
+	}
+	if newState.ImagePath.IsNull() && service.ImagePath != nil {
+		newState.ImagePath = types.StringValue(*service.ImagePath)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// Update is never called because every configurable attribute requires replacement.
+func (r *servicePreviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan servicepreview.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *servicePreviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state servicepreview.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := common.Delete(func() (*http.Response, error) {
+		return r.client.DeleteService(ctx, state.ID.ValueString())
+	}); err != nil {
+		resp.Diagnostics.AddError("Error deleting service preview", err.Error())
+		return
+	}
+}
+
+// ImportState imports a preview from an ID of the form <service_id>/<preview_service_id>.
+func (r *servicePreviewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, previewID, err := common.ParseServiceChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), previewID)...)
+}
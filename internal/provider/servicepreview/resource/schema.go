@@ -0,0 +1,78 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-render/internal/provider/common/validators"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides a preview instance of an image-backed Render service running a different image. Useful for pull request previews when images are built outside of Render. Destroying the resource deletes the preview service.",
+		MarkdownDescription: "Provides a [preview instance](https://render.com/docs/service-previews) of an image-backed Render service running a different image. Useful for pull request previews when images are built outside of Render. Destroying the resource deletes the preview service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the preview service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the image-backed service to preview.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Image to run in the preview. Either a full image URL or a path relative to the parent service's image, e.g. nginx:pr-123. Only the tag or digest may differ from the parent service's image.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the preview service. Defaults to a name generated from the parent service's name and the image tag or digest.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plan": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Plan to use for the preview service. Defaults to the parent service's plan.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL that the preview service is accessible from. Null for background workers.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL to view the preview service in the Render Dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
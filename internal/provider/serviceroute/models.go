@@ -0,0 +1,66 @@
+package serviceroute
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+const pageSize = 100
+
+// Model is the Terraform-side representation of a single redirect or rewrite rule.
+type Model struct {
+	ID          types.String `tfsdk:"id"`
+	ServiceID   types.String `tfsdk:"service_id"`
+	Type        types.String `tfsdk:"type"`
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	Priority    types.Int64  `tfsdk:"priority"`
+}
+
+func ModelFromClient(serviceID string, route client.Route) Model {
+	return Model{
+		ID:          types.StringValue(route.Id),
+		ServiceID:   types.StringValue(serviceID),
+		Type:        types.StringValue(string(route.Type)),
+		Source:      types.StringValue(route.Source),
+		Destination: types.StringValue(route.Destination),
+		Priority:    types.Int64Value(int64(route.Priority)),
+	}
+}
+
+// FindRoute pages through the routes of a service looking for the one with the
+// given ID, returning nil if there is none. There is no endpoint to retrieve a
+// single route.
+func FindRoute(ctx context.Context, apiClient *client.ClientWithResponses, serviceID, routeID string) (*client.Route, error) {
+	limit := pageSize
+	var cursor *string
+
+	for {
+		var page []client.RouteWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListRoutes(ctx, serviceID, &client.ListRoutesParams{
+				Cursor: cursor,
+				Limit:  &limit,
+			})
+		}, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range page {
+			if r.Route.Id == routeID {
+				return &r.Route, nil
+			}
+		}
+
+		if len(page) < limit {
+			return nil, nil
+		}
+		cursor = &page[len(page)-1].Cursor
+	}
+}
@@ -0,0 +1,55 @@
+package serviceroute_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/serviceroute"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func TestFindRoute(t *testing.T) {
+	firstPage := make([]map[string]any, 100)
+	for i := range firstPage {
+		firstPage[i] = map[string]any{
+			"cursor": "page-1",
+			"route":  map[string]any{"id": fmt.Sprintf("rdr-%d", i), "type": "redirect", "source": "/a", "destination": "/b", "priority": i},
+		}
+	}
+
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/services/srv-123/routes": func(resp http.ResponseWriter, req *http.Request) {
+			if req.URL.Query().Get("cursor") == "" {
+				th.StaticResponse(firstPage)(resp, req)
+				return
+			}
+			th.StaticResponse(`[{"cursor": "page-2", "route": {"id": "rdr-last", "type": "rewrite", "source": "/*", "destination": "/index.html", "priority": 100}}]`)(resp, req)
+		},
+	})
+
+	c, err := client.NewClientWithResponses(mockAPI.URL)
+	require.NoError(t, err)
+
+	t.Run("it pages until the route is found", func(t *testing.T) {
+		route, err := serviceroute.FindRoute(context.Background(), c, "srv-123", "rdr-last")
+		require.NoError(t, err)
+		require.NotNil(t, route)
+
+		model := serviceroute.ModelFromClient("srv-123", *route)
+		assert.Equal(t, "rewrite", model.Type.ValueString())
+		assert.Equal(t, int64(100), model.Priority.ValueInt64())
+		assert.Equal(t, "srv-123", model.ServiceID.ValueString())
+	})
+
+	t.Run("it returns nil when the route does not exist", func(t *testing.T) {
+		route, err := serviceroute.FindRoute(context.Background(), c, "srv-123", "rdr-missing")
+		require.NoError(t, err)
+		assert.Nil(t, route)
+	})
+}
@@ -0,0 +1,168 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/serviceroute"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+var (
+	_ resource.Resource                = &serviceRouteResource{}
+	_ resource.ResourceWithConfigure   = &serviceRouteResource{}
+	_ resource.ResourceWithImportState = &serviceRouteResource{}
+)
+
+func NewServiceRouteResource() resource.Resource {
+	return &serviceRouteResource{}
+}
+
+type serviceRouteResource struct {
+	client *client.ClientWithResponses
+}
+
+// routePriorityPatch is the body of a priority change. The generated
+// client.RoutePatch is missing the ID of the route to move.
+type routePriorityPatch struct {
+	ID       string `json:"id"`
+	Priority int    `json:"priority"`
+}
+
+func (r *serviceRouteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := rendertypes.ConfigureResource(req, resp)
+	if data == nil {
+		return
+	}
+	r.client = data.Client
+}
+
+func (r *serviceRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_route"
+}
+
+func (r *serviceRouteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+func (r *serviceRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceroute.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := client.RoutePost{
+		Type:        common.ClientRouteType(plan.Type.ValueString()),
+		Source:      plan.Source.ValueString(),
+		Destination: plan.Destination.ValueString(),
+	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		body.Priority = common.From(int(plan.Priority.ValueInt64()))
+	}
+
+	var created client.Route
+	if err := common.Create(func() (*http.Response, error) {
+		return r.client.AddRoute(ctx, plan.ServiceID.ValueString(), body)
+	}, &created); err != nil {
+		resp.Diagnostics.AddError("Error creating route", err.Error())
+		return
+	}
+
+	state := serviceroute.ModelFromClient(plan.ServiceID.ValueString(), created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *serviceRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceroute.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := serviceroute.FindRoute(ctx, r.client, state.ServiceID.ValueString(), state.ID.ValueString())
+	if common.IsNotFoundErr(err) || (err == nil && route == nil) {
+		common.EmitNotFoundWarning(state.ID.ValueString(), &resp.Diagnostics)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading route", err.Error())
+		return
+	}
+
+	newState := serviceroute.ModelFromClient(state.ServiceID.ValueString(), *route)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *serviceRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serviceroute.Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Everything except the priority requires replacement.
+	serviceID, routeID := plan.ServiceID.ValueString(), plan.ID.ValueString()
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		body, err := json.Marshal(routePriorityPatch{ID: routeID, Priority: int(plan.Priority.ValueInt64())})
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating route", err.Error())
+			return
+		}
+
+		if err := common.Update(func() (*http.Response, error) {
+			return r.client.PatchRouteWithBody(ctx, serviceID, "application/json", bytes.NewReader(body))
+		}, nil); err != nil {
+			resp.Diagnostics.AddError("Error updating route priority", err.Error())
+			return
+		}
+	}
+
+	route, err := serviceroute.FindRoute(ctx, r.client, serviceID, routeID)
+	if err == nil && route == nil {
+		resp.Diagnostics.AddError("Error updating route", "route "+routeID+" no longer exists")
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating route", err.Error())
+		return
+	}
+
+	newState := serviceroute.ModelFromClient(serviceID, *route)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *serviceRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceroute.Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := common.Delete(func() (*http.Response, error) {
+		return r.client.DeleteRoute(ctx, state.ServiceID.ValueString(), state.ID.ValueString())
+	}); err != nil {
+		resp.Diagnostics.AddError("Error deleting route", err.Error())
+		return
+	}
+}
+
+// ImportState imports a route from an ID of the form <service_id>/<route_id>.
+func (r *serviceRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, routeID, err := common.ParseServiceChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), routeID)...)
+}
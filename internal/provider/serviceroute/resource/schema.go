@@ -0,0 +1,72 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-render/internal/provider/common/validators"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides a single redirect or rewrite rule for a Render static site. Use this instead of the routes attribute of render_static_site when rules are owned by different modules.",
+		MarkdownDescription: "Provides a single [redirect or rewrite rule](https://render.com/docs/redirects-rewrites) for a Render static site. Use this instead of the `routes` attribute of `render_static_site` when rules are owned by different modules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for this route.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the static site to add the route to.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of route. Either redirect or rewrite.",
+				Validators:  []validator.String{stringvalidator.OneOf("redirect", "rewrite")},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required:    true,
+				Description: "Source path to match.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Required:    true,
+				Description: "Destination path to route to.",
+				Validators:  []validator.String{validators.StringNotEmpty},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Position of the route in the order rules are applied, starting at 0. Other routes of the site are shifted to make room. When omitted, the route is added last and its position is not managed.",
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
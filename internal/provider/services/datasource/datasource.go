@@ -0,0 +1,108 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/services"
+	rendertypes "terraform-provider-render/internal/provider/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &servicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &servicesDataSource{}
+)
+
+// NewServicesDataSource is a helper function to simplify the provider implementation.
+func NewServicesDataSource() datasource.DataSource {
+	return &servicesDataSource{}
+}
+
+// servicesDataSource is the data source implementation.
+type servicesDataSource struct {
+	client  *client.ClientWithResponses
+	ownerID string
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *servicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data := rendertypes.ConfigureDatasource(req, resp)
+	if data == nil {
+		return
+	}
+
+	d.client = data.Client
+	d.ownerID = data.OwnerID
+}
+
+// Metadata returns the data source type name.
+func (d *servicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+// Schema defines the schema for the data source.
+func (d *servicesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = Schema(ctx)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config services.ServicesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := services.ListParams{Suspended: config.Suspended.ValueBoolPointer()}
+
+	var serviceTypes, regions []string
+	resp.Diagnostics.Append(config.Types.ElementsAs(ctx, &serviceTypes, false)...)
+	resp.Diagnostics.Append(config.Regions.ElementsAs(ctx, &regions, false)...)
+	resp.Diagnostics.Append(config.EnvironmentIDs.ElementsAs(ctx, &params.EnvironmentIDs, false)...)
+	resp.Diagnostics.Append(config.Names.ElementsAs(ctx, &params.Names, false)...)
+	for _, t := range serviceTypes {
+		params.Types = append(params.Types, client.ServiceType(t))
+	}
+	for _, r := range regions {
+		params.Regions = append(params.Regions, client.Region(r))
+	}
+
+	var err error
+	params.CreatedBefore, err = common.TimeFromString(config.CreatedBefore)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("created_before"), "Invalid created_before", err.Error())
+	}
+	params.CreatedAfter, err = common.TimeFromString(config.CreatedAfter)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("created_after"), "Invalid created_after", err.Error())
+	}
+	params.UpdatedBefore, err = common.TimeFromString(config.UpdatedBefore)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("updated_before"), "Invalid updated_before", err.Error())
+	}
+	params.UpdatedAfter, err = common.TimeFromString(config.UpdatedAfter)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("updated_after"), "Invalid updated_after", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := services.List(ctx, d.client, d.ownerID, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list services", err.Error())
+		return
+	}
+
+	config.Services = make([]services.ServiceModel, 0, len(res))
+	for _, s := range res {
+		config.Services = append(config.Services, services.ServiceModelFromClient(s))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
@@ -0,0 +1,111 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/provider/common/validators"
+	"terraform-provider-render/internal/provider/services"
+	"terraform-provider-render/internal/provider/types/datasource"
+	"terraform-provider-render/internal/provider/types/resource"
+)
+
+func Schema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description:         "Provides every Render service in the workspace that matches the filters, e.g. to iterate over the services of an environment with for_each.",
+		MarkdownDescription: "Provides every Render service in the workspace that matches the filters, e.g. to iterate over the services of an environment with `for_each`.",
+		Attributes: map[string]schema.Attribute{
+			"types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return services of these types. Services of every type are returned when omitted.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(services.ServiceTypes...)),
+				},
+			},
+			"environment_ids": datasource.FilterEnvironmentIDs("services"),
+			"regions": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return services in these regions.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(resource.RegionValidator),
+				},
+			},
+			"names": datasource.FilterNames("services"),
+			"suspended": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return suspended services when true, or running services when false.",
+			},
+			"created_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return services created before this RFC 3339 timestamp.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"created_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return services created after this RFC 3339 timestamp.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"updated_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return services last updated before this RFC 3339 timestamp.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"updated_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return services last updated after this RFC 3339 timestamp.",
+				Validators:  []validator.String{validators.RFC3339},
+			},
+			"services": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Services matching the filters. Use the data source of a service's type to read its full configuration.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the service.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the service.",
+						},
+						"slug": schema.StringAttribute{
+							Computed:    true,
+							Description: "Slug of the service, used in its onrender.com subdomain.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the service, e.g. web_service or cron_job.",
+						},
+						"environment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment the service belongs to. Null when it is not in an environment.",
+						},
+						"suspended": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the service is suspended.",
+						},
+						"dashboard_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the service in the Render dashboard.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the service was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the service was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// ListParams filters the services returned by List. Empty fields are not sent.
+type ListParams struct {
+	Types          []client.ServiceType
+	EnvironmentIDs []string
+	Regions        []client.Region
+	Names          []string
+	Suspended      *bool
+	CreatedBefore  *time.Time
+	CreatedAfter   *time.Time
+	UpdatedBefore  *time.Time
+	UpdatedAfter   *time.Time
+}
+
+// List returns every service owned by owner that matches params, paging
+// through the results until they are exhausted.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string, params ListParams) ([]client.Service, error) {
+	query := client.ListServicesParams{
+		OwnerId:       common.From([]string{owner}),
+		Type:          nonEmpty(params.Types),
+		EnvironmentId: nonEmpty(params.EnvironmentIDs),
+		Region:        nonEmpty(params.Regions),
+		Name:          nonEmpty(params.Names),
+		CreatedBefore: params.CreatedBefore,
+		CreatedAfter:  params.CreatedAfter,
+		UpdatedBefore: params.UpdatedBefore,
+		UpdatedAfter:  params.UpdatedAfter,
+	}
+	if params.Suspended != nil {
+		suspended := client.ServiceSuspendedNotSuspended
+		if *params.Suspended {
+			suspended = client.ServiceSuspendedSuspended
+		}
+		query.Suspended = common.From([]string{string(suspended)})
+	}
+
+	res, err := common.ListPages(0, func(cursor *string, pageSize int) ([]client.ServiceWithCursor, error) {
+		page := query
+		page.Cursor = cursor
+		page.Limit = &pageSize
+
+		var res []client.ServiceWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListServices(ctx, &page)
+		}, &res)
+		return res, err
+	}, func(s client.ServiceWithCursor) string { return s.Cursor })
+	if err != nil {
+		return nil, fmt.Errorf("could not list services: %w", err)
+	}
+
+	services := make([]client.Service, 0, len(res))
+	for _, s := range res {
+		services = append(services, s.Service)
+	}
+	return services, nil
+}
+
+func nonEmpty[T any](values []T) *[]T {
+	if len(values) == 0 {
+		return nil
+	}
+	return &values
+}
@@ -0,0 +1,85 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+	"terraform-provider-render/internal/provider/services"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+func servicePage(from, to int) []map[string]any {
+	var page []map[string]any
+	for i := from; i < to; i++ {
+		page = append(page, map[string]any{
+			"cursor": fmt.Sprintf("cursor-%d", i),
+			"service": map[string]any{
+				"id":            fmt.Sprintf("srv-%d", i),
+				"name":          fmt.Sprintf("service-%d", i),
+				"type":          "web_service",
+				"environmentId": "evm-123",
+				"suspended":     "suspended",
+				"createdAt":     "2024-01-01T10:00:00Z",
+				"updatedAt":     "2024-01-02T10:00:00Z",
+			},
+		})
+	}
+	return page
+}
+
+func TestList(t *testing.T) {
+	var queries []url.Values
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/services": func(resp http.ResponseWriter, req *http.Request) {
+			queries = append(queries, req.URL.Query())
+			if req.URL.Query().Get("cursor") == "" {
+				th.StaticResponse(servicePage(0, 100))(resp, req)
+				return
+			}
+			th.StaticResponse(servicePage(100, 130))(resp, req)
+		},
+	})
+	defer mockAPI.Close()
+
+	c, err := client.NewClientWithResponses(mockAPI.URL)
+	require.NoError(t, err)
+
+	createdAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	res, err := services.List(context.Background(), c, "own-123", services.ListParams{
+		Types:          []client.ServiceType{client.WebService, client.CronJob},
+		EnvironmentIDs: []string{"evm-123"},
+		Regions:        []client.Region{client.Oregon},
+		Suspended:      common.From(true),
+		CreatedAfter:   &createdAfter,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res, 130)
+	require.Len(t, queries, 2)
+
+	assert.Equal(t, "own-123", queries[0].Get("ownerId"))
+	assert.Equal(t, "web_service,cron_job", queries[0].Get("type"))
+	assert.Equal(t, "evm-123", queries[0].Get("environmentId"))
+	assert.Equal(t, "oregon", queries[0].Get("region"))
+	assert.Equal(t, "suspended", queries[0].Get("suspended"))
+	assert.Equal(t, "2024-01-01T00:00:00Z", queries[0].Get("createdAfter"))
+	assert.Empty(t, queries[0]["name"])
+	assert.Equal(t, "cursor-99", queries[1].Get("cursor"))
+	assert.Equal(t, "web_service,cron_job", queries[1].Get("type"))
+
+	model := services.ServiceModelFromClient(res[129])
+	assert.Equal(t, "srv-129", model.ID.ValueString())
+	assert.Equal(t, "web_service", model.Type.ValueString())
+	assert.Equal(t, "evm-123", model.EnvironmentID.ValueString())
+	assert.True(t, model.Suspended.ValueBool())
+	assert.Equal(t, "2024-01-02T10:00:00Z", model.UpdatedAt.ValueString())
+}
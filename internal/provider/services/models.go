@@ -0,0 +1,58 @@
+package services
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/provider/common"
+)
+
+type ServicesModel struct {
+	Types          types.Set      `tfsdk:"types"`
+	EnvironmentIDs types.Set      `tfsdk:"environment_ids"`
+	Regions        types.Set      `tfsdk:"regions"`
+	Names          types.Set      `tfsdk:"names"`
+	Suspended      types.Bool     `tfsdk:"suspended"`
+	CreatedBefore  types.String   `tfsdk:"created_before"`
+	CreatedAfter   types.String   `tfsdk:"created_after"`
+	UpdatedBefore  types.String   `tfsdk:"updated_before"`
+	UpdatedAfter   types.String   `tfsdk:"updated_after"`
+	Services       []ServiceModel `tfsdk:"services"`
+}
+
+// ServiceModel summarizes a service of any type. Use the data source of the
+// service's type to read its full configuration.
+type ServiceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Slug          types.String `tfsdk:"slug"`
+	Type          types.String `tfsdk:"type"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	Suspended     types.Bool   `tfsdk:"suspended"`
+	DashboardURL  types.String `tfsdk:"dashboard_url"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// ServiceTypes lists every service type that can be used to filter services.
+var ServiceTypes = []string{
+	string(client.BackgroundWorker),
+	string(client.CronJob),
+	string(client.PrivateService),
+	string(client.StaticSite),
+	string(client.WebService),
+}
+
+func ServiceModelFromClient(s client.Service) ServiceModel {
+	return ServiceModel{
+		ID:            types.StringValue(s.Id),
+		Name:          types.StringValue(s.Name),
+		Slug:          types.StringValue(s.Slug),
+		Type:          types.StringValue(string(s.Type)),
+		EnvironmentID: types.StringPointerValue(s.EnvironmentId),
+		Suspended:     types.BoolValue(s.Suspended == client.ServiceSuspendedSuspended),
+		DashboardURL:  types.StringValue(s.DashboardUrl),
+		CreatedAt:     common.StringFromTime(s.CreatedAt),
+		UpdatedAt:     common.StringFromTime(s.UpdatedAt),
+	}
+}
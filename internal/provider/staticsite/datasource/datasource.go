@@ -51,13 +51,26 @@ func (d *staticSiteSource) Schema(ctx context.Context, _ datasource.SchemaReques
 
 // Read refreshes the Terraform state with the latest data.
 func (d *staticSiteSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan staticsite.StaticSiteModel
+	var plan staticsite.StaticSiteDataSourceModel
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, d.client, d.ownerID, client.StaticSite, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find static site", err.Error())
+		return
+	}
+	plan.Id = id
+
 	staticSite, err := d.client.RetrieveServiceWithResponse(ctx, plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get static site", err.Error())
@@ -75,7 +88,7 @@ func (d *staticSiteSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	staticSitesModel, err := staticsite.ModelForServiceResult(wrappedService, plan, diags)
+	staticSitesModel, err := staticsite.DataSourceModelForServiceResult(wrappedService, plan, diags)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to apply service result to model", err.Error())
 		return
@@ -12,7 +12,7 @@ func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Static Site.",
 		Attributes: map[string]schema.Attribute{
-			"id":                            datasource.ServiceID,
+			"id":                            datasource.LookupID("static site"),
 			"auto_deploy":                   datasource.AutoDeploy,
 			"auto_deploy_trigger":           datasource.AutoDeployTrigger,
 			"branch":                        datasource.Branch,
@@ -20,10 +20,10 @@ func Schema(ctx context.Context) schema.Schema {
 			"build_filter":                  datasource.BuildFilter,
 			"custom_domains":                datasource.CustomDomains,
 			"active_custom_domains":         datasource.ActiveCustomDomains,
-			"environment_id":                datasource.ResourceEnvironmentID,
+			"environment_id":                datasource.LookupEnvironmentID,
 			"env_vars":                      datasource.EnvVars,
 			"headers":                       datasource.Headers,
-			"name":                          datasource.ServiceName,
+			"name":                          datasource.LookupName("static site"),
 			"slug":                          datasource.Slug,
 			"notification_override":         datasource.NotificationOverride,
 			"publish_path":                  datasource.PublishPath,
@@ -1,6 +1,7 @@
 package staticsite
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -9,28 +10,85 @@ import (
 )
 
 type StaticSiteModel struct {
-	Id                         types.String                  `tfsdk:"id"`
-	AutoDeploy                 types.Bool                    `tfsdk:"auto_deploy"`
-	AutoDeployTrigger          types.String                  `tfsdk:"auto_deploy_trigger"`
-	Branch                     types.String                  `tfsdk:"branch"`
-	BuildCommand               types.String                  `tfsdk:"build_command"`
-	BuildFilter                *common.BuildFilterModel      `tfsdk:"build_filter"`
-	EnvironmentID              types.String                  `tfsdk:"environment_id"`
-	CustomDomains              []common.CustomDomainModel    `tfsdk:"custom_domains"`
-	ActiveCustomDomains        types.Set                     `tfsdk:"active_custom_domains"`
-	EnvVars                    map[string]common.EnvVarModel `tfsdk:"env_vars"`
-	Headers                    []common.HeaderModel          `tfsdk:"headers"`
-	IPAllowList                types.Set                     `tfsdk:"ip_allow_list"`
-	Name                       types.String                  `tfsdk:"name"`
-	Slug                       types.String                  `tfsdk:"slug"`
-	NotificationOverride       types.Object                  `tfsdk:"notification_override"`
-	PublishPath                types.String                  `tfsdk:"publish_path"`
-	Previews                   types.Object                  `tfsdk:"previews"`
-	PullRequestPreviewsEnabled types.Bool                    `tfsdk:"pull_request_previews_enabled"`
-	RepoURL                    types.String                  `tfsdk:"repo_url"`
-	RootDirectory              types.String                  `tfsdk:"root_directory"`
-	Routes                     []common.RouteModel           `tfsdk:"routes"`
-	Url                        types.String                  `tfsdk:"url"`
+	Id                         types.String               `tfsdk:"id"`
+	AutoDeploy                 types.Bool                 `tfsdk:"auto_deploy"`
+	AutoDeployTrigger          types.String               `tfsdk:"auto_deploy_trigger"`
+	Branch                     types.String               `tfsdk:"branch"`
+	BuildCommand               types.String               `tfsdk:"build_command"`
+	BuildFilter                *common.BuildFilterModel   `tfsdk:"build_filter"`
+	EnvironmentID              types.String               `tfsdk:"environment_id"`
+	CustomDomains              []common.CustomDomainModel `tfsdk:"custom_domains"`
+	ActiveCustomDomains        types.Set                  `tfsdk:"active_custom_domains"`
+	Headers                    []common.HeaderModel       `tfsdk:"headers"`
+	IPAllowList                types.Set                  `tfsdk:"ip_allow_list"`
+	Name                       types.String               `tfsdk:"name"`
+	Slug                       types.String               `tfsdk:"slug"`
+	NotificationOverride       types.Object               `tfsdk:"notification_override"`
+	PublishPath                types.String               `tfsdk:"publish_path"`
+	Previews                   types.Object               `tfsdk:"previews"`
+	PullRequestPreviewsEnabled types.Bool                 `tfsdk:"pull_request_previews_enabled"`
+	RepoURL                    types.String               `tfsdk:"repo_url"`
+	RootDirectory              types.String               `tfsdk:"root_directory"`
+	Routes                     []common.RouteModel        `tfsdk:"routes"`
+	Url                        types.String               `tfsdk:"url"`
+}
+
+// StaticSiteResourceModel is StaticSiteModel with the env vars, deploy and
+// timeout settings of the render_static_site resource.
+type StaticSiteResourceModel struct {
+	StaticSiteModel
+
+	EnvVars map[string]common.EnvVarModel `tfsdk:"env_vars"`
+
+	IgnoreUnmanagedEnvVars types.Bool              `tfsdk:"ignore_unmanaged_env_vars"`
+	DeployWait             *common.DeployWaitModel `tfsdk:"deploy_wait"`
+	OnUpdate               types.String            `tfsdk:"on_update"`
+	Timeouts               timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// StaticSiteDataSourceModel is the state of the render_static_site data
+// source, whose env vars have no write-only value.
+type StaticSiteDataSourceModel struct {
+	StaticSiteModel
+
+	EnvVars map[string]common.EnvVarDataSourceModel `tfsdk:"env_vars"`
+}
+
+// ResourceModelForServiceResult builds the state of the render_static_site
+// resource.
+func ResourceModelForServiceResult(service *common.WrappedStaticSite, plan StaticSiteResourceModel, diags diag.Diagnostics) (*StaticSiteResourceModel, error) {
+	model, err := ModelForServiceResult(service, plan.StaticSiteModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	envVars := service.EnvVars
+	if plan.IgnoreUnmanagedEnvVars.ValueBool() {
+		envVars = common.ListedEnvVars(envVars, plan.EnvVars)
+	}
+
+	return &StaticSiteResourceModel{
+		StaticSiteModel:        *model,
+		EnvVars:                common.EnvVarsFromClientCursors(envVars, plan.EnvVars),
+		IgnoreUnmanagedEnvVars: plan.IgnoreUnmanagedEnvVars,
+		DeployWait:             plan.DeployWait,
+		OnUpdate:               plan.OnUpdate,
+		Timeouts:               plan.Timeouts,
+	}, nil
+}
+
+// DataSourceModelForServiceResult builds the state of the render_static_site
+// data source.
+func DataSourceModelForServiceResult(service *common.WrappedStaticSite, config StaticSiteDataSourceModel, diags diag.Diagnostics) (*StaticSiteDataSourceModel, error) {
+	model, err := ModelForServiceResult(service, config.StaticSiteModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StaticSiteDataSourceModel{
+		StaticSiteModel: *model,
+		EnvVars:         common.EnvVarsForDataSource(common.EnvVarsFromClientCursors(service.EnvVars, nil)),
+	}, nil
 }
 
 func ModelForServiceResult(service *common.WrappedStaticSite, state StaticSiteModel, diags diag.Diagnostics) (*StaticSiteModel, error) {
@@ -73,7 +131,6 @@ func ModelForServiceResult(service *common.WrappedStaticSite, state StaticSiteMo
 		NotificationOverride: common.NotificationOverrideFromClient(service.NotificationOverride, diags),
 		RootDirectory:        types.StringValue(service.RootDir),
 		Routes:               routes,
-		EnvVars:              common.EnvVarsFromClientCursors(service.EnvVars, state.EnvVars),
 	}
 
 	applyGitBackedFields(service.Service, staticSitesModel, &details)
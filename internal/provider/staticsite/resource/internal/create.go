@@ -8,7 +8,7 @@ import (
 	"terraform-provider-render/internal/provider/staticsite"
 )
 
-func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan staticsite.StaticSiteModel) (client.CreateServiceJSONRequestBody, error) {
+func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan staticsite.StaticSiteResourceModel) (client.CreateServiceJSONRequestBody, error) {
 	var routeModels []client.RoutePost
 	for _, route := range plan.Routes {
 		routeType := common.ClientRouteType(route.Type.ValueString())
@@ -63,7 +63,7 @@ func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan sta
 		Type:           client.StaticSite,
 	}
 
-	updateServiceGitRepoDeployConfigForCreate(plan, &createServiceBody)
+	updateServiceGitRepoDeployConfigForCreate(plan.StaticSiteModel, &createServiceBody)
 
 	return createServiceBody, nil
 }
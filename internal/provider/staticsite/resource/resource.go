@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-render/internal/client"
@@ -12,13 +11,19 @@ import (
 	"terraform-provider-render/internal/provider/staticsite"
 	"terraform-provider-render/internal/provider/staticsite/resource/internal"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 )
 
+// importedKey marks a resource in private state between import and the first
+// read, so that the read takes routes and headers from the API.
+const importedKey = "imported"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &staticSiteResource{}
 	_ resource.ResourceWithConfigure   = &staticSiteResource{}
 	_ resource.ResourceWithImportState = &staticSiteResource{}
+	_ resource.ResourceWithIdentity    = &staticSiteResource{}
 )
 
 // NewStaticSiteResource is a helper function to simplify the provider implementation.
@@ -30,6 +35,8 @@ func NewStaticSiteResource() resource.Resource {
 type staticSiteResource struct {
 	client                       *client.ClientWithResponses
 	ownerID                      string
+	poller                       *common.Poller
+	waitForDeployCompletion      bool
 	skipDeployAfterServiceUpdate bool
 }
 
@@ -42,6 +49,8 @@ func (r *staticSiteResource) Configure(_ context.Context, req resource.Configure
 
 	r.client = data.Client
 	r.ownerID = data.OwnerID
+	r.poller = data.Poller
+	r.waitForDeployCompletion = data.WaitForDeployCompletion
 	r.skipDeployAfterServiceUpdate = data.SkipDeployAfterServiceUpdate
 }
 
@@ -55,15 +64,36 @@ func (r *staticSiteResource) Schema(ctx context.Context, _ resource.SchemaReques
 	resp.Schema = Schema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *staticSiteResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *staticSiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan staticsite.StaticSiteModel
+	var plan staticsite.StaticSiteResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceDetails, err := internal.CreateServiceRequestFromModel(ctx, r.ownerID, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -72,7 +102,7 @@ func (r *staticSiteResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	service, err := common.CreateService(ctx, r.client, common.CreateServiceReq{
+	service, err := common.CreateService(createCtx, r.client, common.CreateServiceReq{
 		Service:              serviceDetails,
 		CustomDomains:        common.CustomDomainModelsToClientCustomDomains(plan.CustomDomains),
 		EnvironmentID:        plan.EnvironmentID.ValueStringPointer(),
@@ -93,7 +123,7 @@ func (r *staticSiteResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	staticSiteModel, err := staticsite.ModelForServiceResult(staticSite, plan, diags)
+	staticSiteModel, err := staticsite.ResourceModelForServiceResult(staticSite, plan, diags)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating static site", "Could not create static site, unexpected error: "+err.Error(),
@@ -102,16 +132,26 @@ func (r *staticSiteResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, staticSiteModel)
+	diags = resp.State.Set(ctx, *staticSiteModel)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
+	if resp.Diagnostics.HasError() || !deployWait.ShouldWait() {
+		return
+	}
+
+	err = common.WaitForService(createCtx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating static site",
+			"Service never started: "+err.Error(),
+		)
 		return
 	}
 }
 
 // Read resource information.
 func (r *staticSiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state staticsite.StaticSiteModel
+	var state staticsite.StaticSiteResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -140,7 +180,7 @@ func (r *staticSiteResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	staticSiteModel, err := staticsite.ModelForServiceResult(staticSite, state, diags)
+	staticSiteModel, err := staticsite.ResourceModelForServiceResult(staticSite, state, diags)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service", "Could not read service, unexpected error: "+err.Error(),
@@ -148,27 +188,67 @@ func (r *staticSiteResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	imported, diags := req.Private.GetKey(ctx, importedKey)
+	resp.Diagnostics.Append(diags...)
+	if imported == nil {
+		// Routes and headers left out of the configuration are not managed by this
+		// resource, so rules added with render_service_route and
+		// render_service_header don't show up as drift.
+		if state.Routes == nil {
+			staticSiteModel.Routes = nil
+		}
+		if state.Headers == nil {
+			staticSiteModel.Headers = nil
+		}
+	} else {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, nil)...)
+	}
+
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, staticSiteModel)
+	diags = resp.State.Set(ctx, *staticSiteModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *staticSiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan staticsite.StaticSiteModel
+	var plan staticsite.StaticSiteResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state staticsite.StaticSiteModel
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+	onUpdate, err := common.UpdateStrategyForPlan(req.State, req.Plan, plan.OnUpdate, r.skipDeployAfterServiceUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service", "Could not determine how to apply the update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state staticsite.StaticSiteResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.UpdateServiceRequestFromModel(ctx, plan, state)
+	serviceDetails, err := internal.UpdateServiceRequestFromModel(ctx, plan.StaticSiteModel, state.StaticSiteModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service", "Could not update service, unexpected error: "+err.Error(),
@@ -192,7 +272,7 @@ func (r *staticSiteResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	wrappedService, err := common.UpdateStaticSite(ctx, r.client, r.skipDeployAfterServiceUpdate, common.UpdateStaticSiteReq{
+	wrappedService, err := common.UpdateStaticSite(ctx, r.client, common.UpdateStaticSiteReq{
 		ServiceID: plan.Id.ValueString(),
 		Service:   serviceDetails,
 		CustomDomains: common.CustomDomainStateAndPlan{
@@ -200,13 +280,17 @@ func (r *staticSiteResource) Update(ctx context.Context, req resource.UpdateRequ
 			Plan:  plan.CustomDomains,
 		},
 		EnvVars:              evs,
-		Headers:              common.ModelToClientHeaderInput(plan.Headers),
+		ListedEnv:            common.NewListedEnv(state.IgnoreUnmanagedEnvVars, plan.IgnoreUnmanagedEnvVars, state.EnvVars, plan.EnvVars, nil, nil),
+		Headers:              headersToClient(plan.Headers, state.Headers),
 		NotificationOverride: notificationOverride,
-		Routes:               common.RouteModelToClientRoutePutInput(plan.Routes),
+		Routes:               routesToClient(plan.Routes, state.Routes),
 		EnvironmentID: &common.EnvironmentIDStateAndPlan{
 			State: state.EnvironmentID.ValueStringPointer(),
 			Plan:  plan.EnvironmentID.ValueStringPointer(),
 		},
+
+		OnUpdate:              onUpdate,
+		CancelPreviousDeploys: deployWait.CancelPreviousDeploys,
 	})
 
 	if err != nil {
@@ -216,7 +300,7 @@ func (r *staticSiteResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	model, err := staticsite.ModelForServiceResult(wrappedService, plan, diags)
+	model, err := staticsite.ResourceModelForServiceResult(wrappedService, plan, diags)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service", "Could not update service, unexpected error: "+err.Error(),
@@ -232,21 +316,39 @@ func (r *staticSiteResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, model)
+	diags = resp.State.Set(ctx, *model)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
+	if resp.Diagnostics.HasError() || !onUpdate.Deploys() || !deployWait.ShouldWait() {
+		return
+	}
+
+	err = common.WaitForService(ctx, r.poller, r.client, wrappedService.Service, wrappedService.DeployID, deployWait.Until, updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service",
+			"Deploy never finished: "+err.Error(),
+		)
 		return
 	}
 }
 
 func (r *staticSiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state staticsite.StaticSiteModel
+	var state staticsite.StaticSiteResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := common.Delete(func() (*http.Response, error) {
 		return r.client.DeleteService(ctx, state.Id.ValueString())
 	})
@@ -259,6 +361,35 @@ func (r *staticSiteResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *staticSiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is either the resource ID or a name reference such as
+	// name:my-api or env:<environment_id>/name:my-api.
+	common.ImportStateByName(ctx, req, resp, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, r.client, r.ownerID, client.StaticSite, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte(`true`))...)
+}
+
+// headersToClient returns nil when headers are unmanaged, leaving the site's
+// headers untouched. Removing headers from the configuration clears them.
+func headersToClient(plan, state []common.HeaderModel) []client.HeaderInput {
+	if plan == nil && state == nil {
+		return nil
+	}
+	return common.ModelToClientHeaderInput(plan)
+}
+
+// routesToClient returns nil when routes are unmanaged, leaving the site's
+// routes untouched. Removing routes from the configuration clears them.
+func routesToClient(plan, state []common.RouteModel) []client.RoutePut {
+	if plan == nil && state == nil {
+		return nil
+	}
+	return common.RouteModelToClientRoutePutInput(plan)
 }
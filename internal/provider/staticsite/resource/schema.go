@@ -22,6 +22,9 @@ func Schema(ctx context.Context) schema.Schema {
 			"active_custom_domains":         resource.ActiveCustomDomains,
 			"environment_id":                resource.ResourceEnvironmentID,
 			"env_vars":                      resource.EnvVars,
+			"ignore_unmanaged_env_vars":     resource.IgnoreUnmanagedEnvVars,
+			"deploy_wait":                   resource.DeployWait,
+			"on_update":                     resource.OnUpdate,
 			"headers":                       resource.Headers,
 			"ip_allow_list":                 resource.IPAllowListOptional,
 			"name":                          resource.ServiceName,
@@ -35,5 +38,8 @@ func Schema(ctx context.Context) schema.Schema {
 			"url":                           resource.ServiceURL,
 			"routes":                        resource.Routes,
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resource.Timeouts(ctx),
+		},
 	}
 }
@@ -0,0 +1,293 @@
+package testhelpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-render/internal/client"
+)
+
+// FakeEnv fakes the env vars and secret files of a service or an environment
+// group. Values set with generateValue are stored as "generated-<key>".
+type FakeEnv struct {
+	mu          sync.Mutex
+	envVars     map[string]string
+	secretFiles map[string]string
+}
+
+func NewFakeEnv() *FakeEnv {
+	return &FakeEnv{envVars: map[string]string{}, secretFiles: map[string]string{}}
+}
+
+// SetEnvVar sets an env var as if it was set outside of Terraform.
+func (e *FakeEnv) SetEnvVar(key, value string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.envVars[key] = value
+}
+
+// EnvVar returns the value of an env var and whether it is set.
+func (e *FakeEnv) EnvVar(key string) (string, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	v, ok := e.envVars[key]
+	return v, ok
+}
+
+// SetSecretFile sets a secret file as if it was set outside of Terraform.
+func (e *FakeEnv) SetSecretFile(name, content string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.secretFiles[name] = content
+}
+
+// SecretFile returns the content of a secret file and whether it is set.
+func (e *FakeEnv) SecretFile(name string) (string, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	v, ok := e.secretFiles[name]
+	return v, ok
+}
+
+// CheckEnvVar checks that an env var is set to value.
+func (e *FakeEnv) CheckEnvVar(key, value string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		got, ok := e.EnvVar(key)
+		if !ok {
+			return fmt.Errorf("env var %s is not set", key)
+		}
+		if got != value {
+			return fmt.Errorf("env var %s is %q, expected %q", key, got, value)
+		}
+		return nil
+	}
+}
+
+// CheckNoEnvVar checks that an env var is not set.
+func (e *FakeEnv) CheckNoEnvVar(key string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if got, ok := e.EnvVar(key); ok {
+			return fmt.Errorf("env var %s is still set to %q", key, got)
+		}
+		return nil
+	}
+}
+
+// CheckSecretFile checks that a secret file exists with content.
+func (e *FakeEnv) CheckSecretFile(name, content string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		got, ok := e.SecretFile(name)
+		if !ok {
+			return fmt.Errorf("secret file %s does not exist", name)
+		}
+		if got != content {
+			return fmt.Errorf("secret file %s contains %q, expected %q", name, got, content)
+		}
+		return nil
+	}
+}
+
+// CheckNoSecretFile checks that a secret file does not exist.
+func (e *FakeEnv) CheckNoSecretFile(name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if _, ok := e.SecretFile(name); ok {
+			return fmt.Errorf("secret file %s still exists", name)
+		}
+		return nil
+	}
+}
+
+// EnvVars returns every env var, sorted by key.
+func (e *FakeEnv) EnvVars() []client.EnvVar {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var res []client.EnvVar
+	for _, k := range sortedKeys(e.envVars) {
+		res = append(res, client.EnvVar{Key: k, Value: e.envVars[k]})
+	}
+	return res
+}
+
+// SecretFiles returns every secret file, sorted by name.
+func (e *FakeEnv) SecretFiles() []client.SecretFile {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var res []client.SecretFile
+	for _, n := range sortedKeys(e.secretFiles) {
+		res = append(res, client.SecretFile{Name: n, Content: e.secretFiles[n]})
+	}
+	return res
+}
+
+// Handlers returns the handlers of the env var and secret file endpoints
+// under prefix, for example /services/srv-1. Listing returns every key on the
+// first page.
+func (e *FakeEnv) Handlers(prefix string) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		prefix + "/env-vars": func(resp http.ResponseWriter, req *http.Request) {
+			switch req.Method {
+			case http.MethodGet:
+				page := []client.EnvVarWithCursor{}
+				if req.URL.Query().Get("cursor") == "" {
+					for _, ev := range e.EnvVars() {
+						page = append(page, client.EnvVarWithCursor{Cursor: ev.Key, EnvVar: ev})
+					}
+				}
+				StaticResponse(page)(resp, req)
+			case http.MethodPut:
+				var input []struct {
+					Key           string `json:"key"`
+					Value         string `json:"value"`
+					GenerateValue bool   `json:"generateValue"`
+				}
+				if err := json.NewDecoder(req.Body).Decode(&input); err != nil {
+					resp.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				e.mu.Lock()
+				e.envVars = map[string]string{}
+				for _, ev := range input {
+					e.envVars[ev.Key] = envValue(ev.Key, ev.Value, ev.GenerateValue)
+				}
+				e.mu.Unlock()
+
+				page := []client.EnvVarWithCursor{}
+				for _, ev := range e.EnvVars() {
+					page = append(page, client.EnvVarWithCursor{Cursor: ev.Key, EnvVar: ev})
+				}
+				StaticResponse(page)(resp, req)
+			default:
+				resp.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		},
+		prefix + "/env-vars/[^/]+": func(resp http.ResponseWriter, req *http.Request) {
+			key := path.Base(req.URL.Path)
+			switch req.Method {
+			case http.MethodGet:
+				v, ok := e.EnvVar(key)
+				if !ok {
+					http.NotFound(resp, req)
+					return
+				}
+				StaticResponse(client.EnvVar{Key: key, Value: v})(resp, req)
+			case http.MethodPut:
+				var input struct {
+					Value         string `json:"value"`
+					GenerateValue bool   `json:"generateValue"`
+				}
+				if err := json.NewDecoder(req.Body).Decode(&input); err != nil {
+					resp.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				v := envValue(key, input.Value, input.GenerateValue)
+				e.SetEnvVar(key, v)
+				StaticResponse(client.EnvVar{Key: key, Value: v})(resp, req)
+			case http.MethodDelete:
+				e.mu.Lock()
+				defer e.mu.Unlock()
+				if _, ok := e.envVars[key]; !ok {
+					http.NotFound(resp, req)
+					return
+				}
+				delete(e.envVars, key)
+				resp.WriteHeader(http.StatusNoContent)
+			default:
+				resp.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		},
+		prefix + "/secret-files": func(resp http.ResponseWriter, req *http.Request) {
+			switch req.Method {
+			case http.MethodGet:
+				page := []client.SecretFileWithCursor{}
+				if req.URL.Query().Get("cursor") == "" {
+					for _, sf := range e.SecretFiles() {
+						page = append(page, client.SecretFileWithCursor{Cursor: sf.Name, SecretFile: sf})
+					}
+				}
+				StaticResponse(page)(resp, req)
+			case http.MethodPut:
+				var input []client.SecretFileInput
+				if err := json.NewDecoder(req.Body).Decode(&input); err != nil {
+					resp.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				e.mu.Lock()
+				e.secretFiles = map[string]string{}
+				for _, sf := range input {
+					e.secretFiles[sf.Name] = sf.Content
+				}
+				e.mu.Unlock()
+
+				page := []client.SecretFileWithCursor{}
+				for _, sf := range e.SecretFiles() {
+					page = append(page, client.SecretFileWithCursor{Cursor: sf.Name, SecretFile: sf})
+				}
+				StaticResponse(page)(resp, req)
+			default:
+				resp.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		},
+		prefix + "/secret-files/[^/]+": func(resp http.ResponseWriter, req *http.Request) {
+			name := path.Base(req.URL.Path)
+			switch req.Method {
+			case http.MethodGet:
+				v, ok := e.SecretFile(name)
+				if !ok {
+					http.NotFound(resp, req)
+					return
+				}
+				StaticResponse(client.SecretFile{Name: name, Content: v})(resp, req)
+			case http.MethodPut:
+				var input struct {
+					Content string `json:"content"`
+				}
+				if err := json.NewDecoder(req.Body).Decode(&input); err != nil {
+					resp.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				e.SetSecretFile(name, input.Content)
+				StaticResponse(client.SecretFile{Name: name, Content: input.Content})(resp, req)
+			case http.MethodDelete:
+				e.mu.Lock()
+				defer e.mu.Unlock()
+				if _, ok := e.secretFiles[name]; !ok {
+					http.NotFound(resp, req)
+					return
+				}
+				delete(e.secretFiles, name)
+				resp.WriteHeader(http.StatusNoContent)
+			default:
+				resp.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		},
+	}
+}
+
+func envValue(key, value string, generate bool) string {
+	if generate {
+		return "generated-" + key
+	}
+	return value
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
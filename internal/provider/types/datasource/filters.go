@@ -0,0 +1,28 @@
+package datasource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FilterNames restricts a plural data source to objects with one of the given
+// names.
+func FilterNames(kinds string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Description: fmt.Sprintf("Only return %s with these names.", kinds),
+	}
+}
+
+// FilterEnvironmentIDs restricts a plural data source to objects in one of the
+// given environments.
+func FilterEnvironmentIDs(kinds string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Description: fmt.Sprintf("Only return %s in these environments.", kinds),
+	}
+}
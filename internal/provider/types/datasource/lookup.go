@@ -0,0 +1,47 @@
+package datasource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// LookupID is the id of a data source that can also look up its object by
+// name.
+func LookupID(kind string) schema.StringAttribute {
+	description := fmt.Sprintf("Unique identifier for the %s. Exactly one of id and name must be set.", kind)
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: fmt.Sprintf("Unique identifier for the %s. Exactly one of `id` and `name` must be set.", kind),
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+}
+
+// LookupName is the name of a data source that can look up its object by name
+// instead of by id.
+func LookupName(kind string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         fmt.Sprintf("Name of the %s. When id is not set, the %s with this name is looked up. It is an error for the name to match more than one %s.", kind, kind, kind),
+		MarkdownDescription: fmt.Sprintf("Name of the %s. When `id` is not set, the %s with this name is looked up. It is an error for the name to match more than one %s.", kind, kind, kind),
+	}
+}
+
+// LookupEnvironmentID narrows a lookup by name to a single environment.
+var LookupEnvironmentID = schema.StringAttribute{
+	Optional:            true,
+	Computed:            true,
+	Description:         "Unique identifier for the environment that the resource belongs to. When looking up by name, only resources in this environment match.",
+	MarkdownDescription: "Unique identifier for the environment that the resource belongs to. When looking up by `name`, only resources in this environment match.",
+	Validators: []validator.String{
+		stringvalidator.ConflictsWith(path.MatchRoot("id")),
+	},
+}
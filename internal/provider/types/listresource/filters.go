@@ -0,0 +1,48 @@
+package listresource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var OwnerID = schema.StringAttribute{
+	Optional:    true,
+	Description: "The user or team ID to list resources for. Defaults to the provider's owner_id.",
+}
+
+var EnvironmentIDs = schema.ListAttribute{
+	ElementType: types.StringType,
+	Optional:    true,
+	Description: "Only list resources in these environments.",
+}
+
+// Schema returns the list resource config schema. Resources that don't belong
+// to an environment have no environment_ids filter.
+func Schema(description string, withEnvironments bool) schema.Schema {
+	attributes := map[string]schema.Attribute{
+		"owner_id": OwnerID,
+	}
+	if withEnvironments {
+		attributes["environment_ids"] = EnvironmentIDs
+	}
+
+	return schema.Schema{
+		Description: description,
+		Attributes:  attributes,
+	}
+}
+
+// Identity is the resource identity schema shared by Render resources.
+var Identity = identityschema.Schema{
+	Attributes: map[string]identityschema.Attribute{
+		"owner_id": identityschema.StringAttribute{
+			OptionalForImport: true,
+			Description:       "The user or team ID that owns the resource.",
+		},
+		"id": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "Unique identifier for the resource.",
+		},
+	},
+}
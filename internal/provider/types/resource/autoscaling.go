@@ -26,7 +26,7 @@ var Autoscaling = schema.SingleNestedAttribute{
 					Optional: true,
 					Computed: true,
 					PlanModifiers: []planmodifier.Object{
-						objectplanmodifier.UseStateForUnknown(),
+						objectplanmodifier.UseNonNullStateForUnknown(),
 					},
 				},
 				"memory": schema.SingleNestedAttribute{
@@ -43,7 +43,7 @@ var Autoscaling = schema.SingleNestedAttribute{
 					Optional: true,
 					Computed: true,
 					PlanModifiers: []planmodifier.Object{
-						objectplanmodifier.UseStateForUnknown(),
+						objectplanmodifier.UseNonNullStateForUnknown(),
 					},
 				},
 			},
@@ -0,0 +1,30 @@
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-render/internal/provider/common"
+)
+
+var DeployWait = schema.SingleNestedAttribute{
+	Optional:            true,
+	Description:         "Controls how Terraform waits for the deploys this resource starts on create and update. Overrides the provider's wait_for_deploy_completion.",
+	MarkdownDescription: "Controls how Terraform waits for the deploys this resource starts on create and update. Overrides the provider's `wait_for_deploy_completion`.",
+	Attributes: map[string]schema.Attribute{
+		"until": schema.StringAttribute{
+			Optional:            true,
+			Description:         "How far a deploy must get before Terraform continues. Must be one of live, build or none. Defaults to live when the provider waits for deploys, and none otherwise.",
+			MarkdownDescription: "How far a deploy must get before Terraform continues. Must be one of `live`, `build` or `none`. `build` returns as soon as the build finishes, before the new version takes traffic. Defaults to `live` when the provider waits for deploys, and `none` otherwise.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(common.DeployWaitUntilValues...),
+			},
+		},
+		"cancel_previous_deploys": schema.BoolAttribute{
+			Optional:            true,
+			Description:         "Whether to cancel deploys that are still in progress before starting a new one on update. Defaults to false.",
+			MarkdownDescription: "Whether to cancel deploys that are still in progress before starting a new one on update. Defaults to `false`.",
+		},
+	},
+}
@@ -22,7 +22,7 @@ var EnvironmentID = schema.StringAttribute{
 	Computed:    true,
 	Description: "Unique identifier of the environment",
 	PlanModifiers: []planmodifier.String{
-		stringplanmodifier.UseStateForUnknown(),
+		stringplanmodifier.UseNonNullStateForUnknown(),
 	},
 }
 
@@ -3,6 +3,7 @@ package resource
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -16,14 +17,32 @@ var EnvVar = schema.NestedAttributeObject{
 			Computed:  true,
 			Sensitive: true,
 		},
+		"value_wo": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			Description:         "Write-only value of the variable. It is sent to Render but never stored in state. Requires value_wo_version.",
+			MarkdownDescription: "Write-only value of the variable. It is sent to Render but never stored in state. Requires `value_wo_version`.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("value")),
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("value_wo_version")),
+			},
+		},
+		"value_wo_version": WriteOnlyVersion("value_wo"),
 		"generate_value": schema.BoolAttribute{
 			Optional:    true,
 			Computed:    true,
 			Description: "If true, Render will generate the variable value.",
 			Default:     booldefault.StaticBool(false),
 			Validators: []validator.Bool{
-				boolvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("value")),
-				boolvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("value")),
+				boolvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("value"),
+					path.MatchRelative().AtParent().AtName("value_wo"),
+				),
+				boolvalidator.AtLeastOneOf(
+					path.MatchRelative().AtParent().AtName("value"),
+					path.MatchRelative().AtParent().AtName("value_wo"),
+				),
 			},
 		},
 	},
@@ -37,3 +56,9 @@ var EnvVars = schema.MapNestedAttribute{
 		mapvalidator.SizeAtLeast(1),
 	},
 }
+
+var IgnoreUnmanagedEnvVars = schema.BoolAttribute{
+	Optional:            true,
+	Description:         "When true, only the env vars and secret files listed in this resource are managed. Keys set elsewhere, for example with render_env_var or render_secret_file, are left alone instead of being removed. Defaults to false.",
+	MarkdownDescription: "When `true`, only the env vars and secret files listed in this resource are managed. Keys set elsewhere, for example with `render_env_var` or `render_secret_file`, are left alone instead of being removed. Defaults to `false`.",
+}
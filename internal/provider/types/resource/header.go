@@ -9,8 +9,8 @@ import (
 
 var Headers = schema.SetNestedAttribute{
 	Optional:            true,
-	Description:         "List of headers to apply to requests for static sites",
-	MarkdownDescription: "List of [headers](https://render.com/docs/static-site-headers) to apply to requests for static sites",
+	Description:         "List of headers to apply to requests for static sites. When omitted, headers are left unmanaged so they can be managed with render_service_header.",
+	MarkdownDescription: "List of [headers](https://render.com/docs/static-site-headers) to apply to requests for static sites. When omitted, headers are left unmanaged so they can be managed with `render_service_header`.",
 	NestedObject: schema.NestedAttributeObject{
 		Attributes: map[string]schema.Attribute{
 			"path": schema.StringAttribute{
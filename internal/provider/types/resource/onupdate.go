@@ -0,0 +1,18 @@
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-render/internal/provider/common"
+)
+
+var OnUpdate = schema.StringAttribute{
+	Optional:            true,
+	Description:         "What happens to the running service after Terraform updates it. Must be one of deploy, deploy_clear_cache, restart or none. Defaults to deploy, or to none when the provider's skip_deploy_after_service_update is set. Updates that only change settings such as custom domains, notification overrides or scaling never deploy or restart the service.",
+	MarkdownDescription: "What happens to the running service after Terraform updates it. Must be one of `deploy`, `deploy_clear_cache`, `restart` or `none`. Defaults to `deploy`, or to `none` when the provider's `skip_deploy_after_service_update` is set. Updates that only change settings such as custom domains, notification overrides or scaling never deploy or restart the service.",
+	Validators: []validator.String{
+		stringvalidator.OneOf(common.UpdateStrategyValues...),
+	},
+}
@@ -2,6 +2,8 @@ package resource
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -9,10 +11,25 @@ import (
 var SecretFile = schema.NestedAttributeObject{
 	Attributes: map[string]schema.Attribute{
 		"content": schema.StringAttribute{
-			Description: "The content of the secret file.",
-			Required:    true,
-			Sensitive:   true,
+			Description:         "The content of the secret file. Exactly one of content or content_wo must be set.",
+			MarkdownDescription: "The content of the secret file. Exactly one of `content` or `content_wo` must be set.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("content_wo")),
+			},
 		},
+		"content_wo": schema.StringAttribute{
+			Description:         "Write-only content of the secret file. It is sent to Render but never stored in state. Requires content_wo_version.",
+			MarkdownDescription: "Write-only content of the secret file. It is sent to Render but never stored in state. Requires `content_wo_version`.",
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("content_wo_version")),
+			},
+		},
+		"content_wo_version": WriteOnlyVersion("content_wo"),
 	},
 }
 
@@ -78,8 +78,8 @@ var RegionValidator = stringvalidator.OneOf(
 )
 
 var KeyValueConnectionInfo = schema.SingleNestedAttribute{
-	Description:         "Key Value connection info.",
-	MarkdownDescription: "Key Value connection info.",
+	Description:         "Key Value connection info. Null when the provider sets omit_connection_info_from_state; use the render_keyvalue_connection_info ephemeral resource instead.",
+	MarkdownDescription: "Key Value connection info. Null when the provider sets `omit_connection_info_from_state`; use the `render_keyvalue_connection_info` ephemeral resource instead.",
 	Computed:            true,
 	Sensitive:           true,
 	Attributes: map[string]schema.Attribute{
@@ -102,8 +102,8 @@ var KeyValueConnectionInfo = schema.SingleNestedAttribute{
 }
 
 var RedisConnectionInfo = schema.SingleNestedAttribute{
-	Description:         "Redis connection info.",
-	MarkdownDescription: "Redis connection info.",
+	Description:         "Redis connection info. Null when the provider sets omit_connection_info_from_state.",
+	MarkdownDescription: "Redis connection info. Null when the provider sets `omit_connection_info_from_state`.",
 	Computed:            true,
 	Sensitive:           true,
 	Attributes: map[string]schema.Attribute{
@@ -311,8 +311,8 @@ var RootDirectory = schema.StringAttribute{
 
 var Routes = schema.ListNestedAttribute{
 	Optional:            true,
-	Description:         "List of redirect and rewrite rules to apply to a static site.",
-	MarkdownDescription: "List of [redirect and rewrite rules](https://render.com/docs/redirects-rewrites) to apply to a static site.",
+	Description:         "List of redirect and rewrite rules to apply to a static site. When omitted, routes are left unmanaged so they can be managed with render_service_route.",
+	MarkdownDescription: "List of [redirect and rewrite rules](https://render.com/docs/redirects-rewrites) to apply to a static site. When omitted, routes are left unmanaged so they can be managed with `render_service_route`.",
 	NestedObject: schema.NestedAttributeObject{
 		Attributes: map[string]schema.Attribute{
 			"source": schema.StringAttribute{
@@ -354,7 +354,7 @@ var Disk = schema.SingleNestedAttribute{
 			Computed:    true,
 			Description: "Unique identifier for the disk",
 			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.UseNonNullStateForUnknown(),
 			},
 		},
 		"name": schema.StringAttribute{
@@ -0,0 +1,24 @@
+package resource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// WriteOnlyVersion is the version attribute paired with a write-only attribute.
+// Terraform never stores write-only values, so changing the version is what
+// sends a new value to Render.
+func WriteOnlyVersion(writeOnlyAttribute string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:            true,
+		Description:         fmt.Sprintf("Version of %s. Change it to send a new %s to Render.", writeOnlyAttribute, writeOnlyAttribute),
+		MarkdownDescription: fmt.Sprintf("Version of `%s`. Change it to send a new `%s` to Render.", writeOnlyAttribute, writeOnlyAttribute),
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(writeOnlyAttribute)),
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-render/internal/client"
@@ -16,6 +17,7 @@ type Data struct {
 	Poller                       *common.Poller
 	WaitForDeployCompletion      bool
 	SkipDeployAfterServiceUpdate bool
+	OmitConnectionInfoFromState  bool
 }
 
 func ConfigureDatasource(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *Data {
@@ -53,3 +55,21 @@ func ConfigureResource(req resource.ConfigureRequest, resp *resource.ConfigureRe
 
 	return data
 }
+
+func ConfigureEphemeralResource(req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) *Data {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	data, ok := req.ProviderData.(*Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil
+	}
+
+	return data
+}
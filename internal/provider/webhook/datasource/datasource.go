@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-render/internal/client/webhooks"
 	"terraform-provider-render/internal/provider/webhook"
@@ -62,9 +63,22 @@ func (d *webhookDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, types.StringNull(), func(ref common.NameRef) (string, error) {
+		found, err := webhook.FindByName(ctx, d.client, d.ownerID, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("unable to find webhook", err.Error())
+		return
+	}
+	plan.Id = id
+
 	var whk webhooks.Webhook
 
-	err := common.Get(func() (*http.Response, error) {
+	err = common.Get(func() (*http.Response, error) {
 		return d.client.RetrieveWebhook(ctx, plan.Id.ValueString())
 	}, &whk)
 	if err != nil {
@@ -0,0 +1,82 @@
+package datasource_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/client/webhooks"
+	"terraform-provider-render/internal/provider"
+	th "terraform-provider-render/internal/provider/testhelpers"
+)
+
+const providerCfg = `
+provider "render" {
+  api_key = "some-api-key"
+  owner_id = "some-owner-id"
+}
+`
+
+// webhookPage returns a full page of webhooks, so that the lookup has to
+// fetch the next one.
+func webhookPage() []client.WebhookWithCursor {
+	var page []client.WebhookWithCursor
+	for i := range 100 {
+		id := fmt.Sprintf("whk-%d", i)
+		page = append(page, client.WebhookWithCursor{Cursor: id, Webhook: webhooks.Webhook{Id: id, Name: id}})
+	}
+	return page
+}
+
+func TestWebhookDataSourceByName(t *testing.T) {
+	webhook := webhooks.Webhook{
+		Id:          "whk-123",
+		Name:        "deploys",
+		Url:         "https://example.com/hooks",
+		Enabled:     true,
+		Secret:      "some-secret",
+		EventFilter: webhooks.EventFilter{},
+	}
+	mockAPI := th.NewMockRenderAPI(map[string]http.HandlerFunc{
+		"/webhooks": func(resp http.ResponseWriter, req *http.Request) {
+			if req.URL.Query().Get("cursor") == "" {
+				th.StaticResponse(webhookPage())(resp, req)
+				return
+			}
+			th.StaticResponse([]client.WebhookWithCursor{
+				{Cursor: "c1", Webhook: webhook},
+				{Cursor: "c2", Webhook: webhooks.Webhook{Id: "whk-456", Name: "builds"}},
+				{Cursor: "c3", Webhook: webhooks.Webhook{Id: "whk-789", Name: "builds"}},
+			})(resp, req)
+		},
+		"/webhooks/whk-123": th.StaticResponse(webhook),
+	})
+	defer mockAPI.Close()
+
+	resourceName := "data.render_webhook.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"render": providerserver.NewProtocol6WithError(provider.New("test", provider.WithHost(mockAPI.URL))()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerCfg + `data "render_webhook" "test" { name = "deploys" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "whk-123"),
+					resource.TestCheckResourceAttr(resourceName, "name", "deploys"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/hooks"),
+				),
+			},
+			{
+				Config:      providerCfg + `data "render_webhook" "test" { name = "builds" }`,
+				ExpectError: regexp.MustCompile(`2 webhooks are named "builds"`),
+			},
+		},
+	})
+}
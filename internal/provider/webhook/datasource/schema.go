@@ -5,19 +5,15 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-render/internal/provider/types/datasource"
 )
 
 func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "Unique identifier of the webhook",
-			},
-			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Name of the webhook",
-			},
+			"id":   datasource.LookupID("webhook"),
+			"name": datasource.LookupName("webhook"),
 			"enabled": schema.BoolAttribute{
 				Computed:    true,
 				Description: "whether or not the webhook is enabled",
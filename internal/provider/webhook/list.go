@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-render/internal/client"
+	"terraform-provider-render/internal/client/webhooks"
+	"terraform-provider-render/internal/provider/common"
+)
+
+// List lists the webhooks owned by owner.
+func List(ctx context.Context, apiClient *client.ClientWithResponses, owner string) ([]webhooks.Webhook, error) {
+	res, err := common.ListPages(0, func(cursor *string, pageSize int) ([]client.WebhookWithCursor, error) {
+		var page []client.WebhookWithCursor
+		err := common.Get(func() (*http.Response, error) {
+			return apiClient.ListWebhooks(ctx, &client.ListWebhooksParams{
+				OwnerId: common.From([]string{owner}),
+				Cursor:  cursor,
+				Limit:   &pageSize,
+			})
+		}, &page)
+		return page, err
+	}, func(w client.WebhookWithCursor) string { return w.Cursor })
+	if err != nil {
+		return nil, fmt.Errorf("could not list webhooks: %w", err)
+	}
+
+	items := make([]webhooks.Webhook, 0, len(res))
+	for _, w := range res {
+		items = append(items, w.Webhook)
+	}
+	return items, nil
+}
+
+// FindByName returns the only webhook named ref.Name. The API cannot filter
+// webhooks by name, so every webhook of the owner is listed. Webhooks do not
+// belong to an environment, so ref must not name one.
+func FindByName(ctx context.Context, apiClient *client.ClientWithResponses, owner string, ref common.NameRef) (*webhooks.Webhook, error) {
+	if ref.EnvironmentID != nil {
+		return nil, errors.New("webhooks cannot be looked up by environment")
+	}
+
+	items, err := List(ctx, apiClient, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := common.FindByName("webhook", ref, items, func(w webhooks.Webhook) (string, string) { return w.Id, w.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
@@ -51,20 +51,33 @@ func (d *webServiceSource) Schema(ctx context.Context, _ datasource.SchemaReques
 
 // Read refreshes the Terraform state with the latest data.
 func (d *webServiceSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan webservice.WebServiceModel
+	var plan webservice.WebServiceDataSourceModel
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	id, err := common.ResolveID(plan.Id, plan.Name, plan.EnvironmentID, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, d.client, d.ownerID, client.WebService, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to find web service", err.Error())
+		return
+	}
+	plan.Id = id
+
 	service, err := common.GetWrappedService(ctx, d.client, plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get web service", err.Error())
 		return
 	}
 
-	webServicesModel, err := webservice.ModelForServiceResult(service, plan, resp.Diagnostics)
+	webServicesModel, err := webservice.DataSourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to apply service result to model", err.Error())
 		return
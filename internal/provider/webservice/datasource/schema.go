@@ -11,15 +11,15 @@ func Schema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Description: "Provides information about a Render Web Service.",
 		Attributes: map[string]schema.Attribute{
-			"id":                            datasource.ServiceID,
+			"id":                            datasource.LookupID("web service"),
 			"autoscaling":                   datasource.Autoscaling,
 			"custom_domains":                datasource.CustomDomains,
 			"active_custom_domains":         datasource.ActiveCustomDomains,
 			"runtime_source":                datasource.RuntimeSource,
 			"disk":                          datasource.Disk,
-			"environment_id":                datasource.ResourceEnvironmentID,
+			"environment_id":                datasource.LookupEnvironmentID,
 			"health_check_path":             datasource.HealthCheckPath,
-			"name":                          datasource.ServiceName,
+			"name":                          datasource.LookupName("web service"),
 			"slug":                          datasource.Slug,
 			"num_instances":                 datasource.NumInstances,
 			"plan":                          datasource.Plan,
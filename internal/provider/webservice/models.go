@@ -4,6 +4,7 @@ import (
 	"terraform-provider-render/internal/client"
 	"terraform-provider-render/internal/provider/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -32,11 +33,72 @@ type WebServiceModel struct {
 	MaintenanceMode            types.Object               `tfsdk:"maintenance_mode"`
 	IPAllowList                types.Set                  `tfsdk:"ip_allow_list"`
 
+	NotificationOverride types.Object `tfsdk:"notification_override"`
+	LogStreamOverride    types.Object `tfsdk:"log_stream_override"`
+}
+
+// WebServiceResourceModel is the state of the render_web_service resource.
+// The data source shares WebServiceModel, which has none of the settings that
+// only control how the resource applies changes.
+type WebServiceResourceModel struct {
+	WebServiceModel
+
 	EnvVars     map[string]common.EnvVarModel     `tfsdk:"env_vars"`
 	SecretFiles map[string]common.SecretFileModel `tfsdk:"secret_files"`
 
-	NotificationOverride types.Object `tfsdk:"notification_override"`
-	LogStreamOverride    types.Object `tfsdk:"log_stream_override"`
+	IgnoreUnmanagedEnvVars types.Bool              `tfsdk:"ignore_unmanaged_env_vars"`
+	DeployWait             *common.DeployWaitModel `tfsdk:"deploy_wait"`
+	OnUpdate               types.String            `tfsdk:"on_update"`
+	Timeouts               timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// WebServiceDataSourceModel is the state of the render_web_service data source.
+type WebServiceDataSourceModel struct {
+	WebServiceModel
+
+	EnvVars     map[string]common.EnvVarDataSourceModel     `tfsdk:"env_vars"`
+	SecretFiles map[string]common.SecretFileDataSourceModel `tfsdk:"secret_files"`
+}
+
+// ResourceModelForServiceResult builds the resource state from service. Env
+// vars and secret files that are not listed in plan are dropped when plan
+// ignores unmanaged env vars.
+func ResourceModelForServiceResult(service *common.WrappedService, plan WebServiceResourceModel, diags diag.Diagnostics) (*WebServiceResourceModel, error) {
+	model, err := ModelForServiceResult(service, plan.WebServiceModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	envVars := service.EnvVars
+	secretFiles := service.SecretFiles
+	if plan.IgnoreUnmanagedEnvVars.ValueBool() {
+		envVars = common.ListedEnvVars(envVars, plan.EnvVars)
+		secretFiles = common.ListedSecretFiles(secretFiles, plan.SecretFiles)
+	}
+
+	return &WebServiceResourceModel{
+		WebServiceModel:        *model,
+		EnvVars:                common.EnvVarsFromClientCursors(envVars, plan.EnvVars),
+		SecretFiles:            common.SecretFilesFromClientCursors(secretFiles, plan.SecretFiles),
+		IgnoreUnmanagedEnvVars: plan.IgnoreUnmanagedEnvVars,
+		DeployWait:             plan.DeployWait,
+		OnUpdate:               plan.OnUpdate,
+		Timeouts:               plan.Timeouts,
+	}, nil
+}
+
+// DataSourceModelForServiceResult builds the data source state from service.
+func DataSourceModelForServiceResult(service *common.WrappedService, config WebServiceDataSourceModel, diags diag.Diagnostics) (*WebServiceDataSourceModel, error) {
+	model, err := ModelForServiceResult(service, config.WebServiceModel, diags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebServiceDataSourceModel{
+		WebServiceModel: *model,
+		EnvVars:         common.EnvVarsForDataSource(common.EnvVarsFromClientCursors(service.EnvVars, nil)),
+		SecretFiles:     common.SecretFilesForDataSource(common.SecretFilesFromClientCursors(service.SecretFiles, nil)),
+	}, nil
 }
 
 func ModelForServiceResult(service *common.WrappedService, plan WebServiceModel, diags diag.Diagnostics) (*WebServiceModel, error) {
@@ -84,8 +146,6 @@ func ModelForServiceResult(service *common.WrappedService, plan WebServiceModel,
 		MaintenanceMode:      common.MaintenanceModeFromClient(details.MaintenanceMode, diags),
 		Autoscaling:          common.AutoscalingFromClient(details.Autoscaling, diags),
 		Disk:                 common.DiskToDiskModel(details.Disk),
-		EnvVars:              common.EnvVarsFromClientCursors(service.EnvVars, plan.EnvVars),
-		SecretFiles:          common.SecretFilesFromClientCursors(service.SecretFiles),
 		NotificationOverride: common.NotificationOverrideFromClient(service.NotificationOverride, diags),
 		LogStreamOverride:    common.LogStreamOverrideFromClient(service.LogStreamOverride, plan.LogStreamOverride, diags),
 	}
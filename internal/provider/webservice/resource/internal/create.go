@@ -7,7 +7,7 @@ import (
 	"terraform-provider-render/internal/provider/webservice"
 )
 
-func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan webservice.WebServiceModel) (client.CreateServiceJSONRequestBody, error) {
+func CreateServiceRequestFromModel(ctx context.Context, ownerID string, plan webservice.WebServiceResourceModel) (client.CreateServiceJSONRequestBody, error) {
 	envSpecificDetails, err := common.EnvSpecificDetailsForRuntimeSource(
 		plan.RuntimeSource.Runtime(),
 		plan.RuntimeSource,
@@ -4,12 +4,12 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-render/internal/client"
 	"terraform-provider-render/internal/provider/common"
 	rendertypes "terraform-provider-render/internal/provider/types"
+	"terraform-provider-render/internal/provider/types/listresource"
 	resourcecommon "terraform-provider-render/internal/provider/types/resource"
 	"terraform-provider-render/internal/provider/webservice"
 	"terraform-provider-render/internal/provider/webservice/resource/internal"
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                     = &webServiceResource{}
 	_ resource.ResourceWithConfigure        = &webServiceResource{}
 	_ resource.ResourceWithImportState      = &webServiceResource{}
+	_ resource.ResourceWithIdentity         = &webServiceResource{}
 	_ resource.ResourceWithConfigValidators = &webServiceResource{}
 )
 
@@ -61,16 +62,39 @@ func (r *webServiceResource) Schema(ctx context.Context, _ resource.SchemaReques
 	resp.Schema = Schema(ctx)
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *webServiceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = listresource.Identity
+}
+
 // Create a new resource.
 func (r *webServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var shouldWaitForServiceCompletion = r.waitForDeployCompletion
-	var plan webservice.WebServiceModel
+	var plan webservice.WebServiceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+	shouldWaitForServiceCompletion := deployWait.ShouldWait()
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	serviceDetails, err := internal.CreateServiceRequestFromModel(ctx, r.ownerID, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -79,7 +103,7 @@ func (r *webServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	_, err = common.CreateService(ctx, r.client, common.CreateServiceReq{
+	service, err := common.CreateService(createCtx, r.client, common.CreateServiceReq{
 		Service:              serviceDetails,
 		CustomDomains:        common.CustomDomainModelsToClientCustomDomains(plan.CustomDomains),
 		EnvironmentID:        plan.EnvironmentID.ValueStringPointer(),
@@ -96,15 +120,22 @@ func (r *webServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		shouldWaitForServiceCompletion = false
 	}
 
-	service, err := common.GetWrappedServiceByName(ctx, r.client, r.ownerID, plan.Name.ValueString(), client.WebService)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating web service", "Could not find service, unexpected error: "+err.Error(),
-		)
+	if service == nil {
 		return
 	}
+	if err != nil {
+		// Re-read the partially created service so that it is tracked in
+		// state and can be repaired or destroyed by a later apply.
+		service, err = common.GetWrappedService(ctx, r.client, service.Id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating web service", "Could not read service, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
 
-	model, err := webservice.ModelForServiceResult(service, plan, resp.Diagnostics)
+	model, err := webservice.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating web service", "Could not create web service, unexpected error: "+err.Error(),
@@ -115,12 +146,13 @@ func (r *webServiceResource) Create(ctx context.Context, req resource.CreateRequ
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, *model)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 
 	if !shouldWaitForServiceCompletion {
 		return
 	}
 
-	err = common.WaitForService(ctx, r.poller, r.client, service.Id)
+	err = common.WaitForService(createCtx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating web service",
@@ -132,7 +164,7 @@ func (r *webServiceResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read resource information.
 func (r *webServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state webservice.WebServiceModel
+	var state webservice.WebServiceResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -153,7 +185,7 @@ func (r *webServiceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	webServiceModel, err := webservice.ModelForServiceResult(service, state, resp.Diagnostics)
+	webServiceModel, err := webservice.ResourceModelForServiceResult(service, state, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service",
@@ -163,26 +195,52 @@ func (r *webServiceResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, webServiceModel)
+	diags = resp.State.Set(ctx, *webServiceModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
 }
 
 func (r *webServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan webservice.WebServiceModel
+	var plan webservice.WebServiceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state webservice.WebServiceModel
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultServiceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	deployWait := common.DeployWaitFromModel(plan.DeployWait, r.waitForDeployCompletion)
+	onUpdate, err := common.UpdateStrategyForPlan(req.State, req.Plan, plan.OnUpdate, r.skipDeployAfterServiceUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service", "Could not determine how to apply the update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.EnvVars, diags = common.EnvVarsWithWriteOnlyValues(ctx, req.Config, plan.EnvVars)
+	resp.Diagnostics.Append(diags...)
+	plan.SecretFiles, diags = common.SecretFilesWithWriteOnlyContent(ctx, req.Config, plan.SecretFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state webservice.WebServiceResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	serviceDetails, err := internal.UpdateServiceRequestFromModel(ctx, plan, state, r.ownerID)
+	serviceDetails, err := internal.UpdateServiceRequestFromModel(ctx, plan.WebServiceModel, state.WebServiceModel, r.ownerID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service",
@@ -208,7 +266,7 @@ func (r *webServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	service, err := common.UpdateService(ctx, r.client, r.skipDeployAfterServiceUpdate, common.UpdateServiceReq{
+	service, err := common.UpdateService(ctx, r.client, common.UpdateServiceReq{
 		ServiceID: plan.Id.ValueString(),
 		Service:   serviceDetails,
 		CustomDomains: common.CustomDomainStateAndPlan{
@@ -217,6 +275,7 @@ func (r *webServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		},
 		EnvVars:     evs,
 		SecretFiles: common.SecretFilesToClient(plan.SecretFiles),
+		ListedEnv:   common.NewListedEnv(state.IgnoreUnmanagedEnvVars, plan.IgnoreUnmanagedEnvVars, state.EnvVars, plan.EnvVars, state.SecretFiles, plan.SecretFiles),
 		Disk: &common.DiskStateAndPlan{
 			State: state.Disk,
 			Plan:  plan.Disk,
@@ -235,6 +294,9 @@ func (r *webServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 			State: state.LogStreamOverride,
 			Plan:  plan.LogStreamOverride,
 		},
+
+		OnUpdate:              onUpdate,
+		CancelPreviousDeploys: deployWait.CancelPreviousDeploys,
 	}, common.ServiceTypeWebService)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -244,7 +306,7 @@ func (r *webServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	webServiceModel, err := webservice.ModelForServiceResult(service, plan, resp.Diagnostics)
+	webServiceModel, err := webservice.ResourceModelForServiceResult(service, plan, resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service",
@@ -253,21 +315,39 @@ func (r *webServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	diags = resp.State.Set(ctx, webServiceModel)
+	diags = resp.State.Set(ctx, *webServiceModel)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	resp.Diagnostics.Append(common.SetIdentity(ctx, resp.State, resp.Identity, r.ownerID)...)
+	if resp.Diagnostics.HasError() || !onUpdate.Deploys() || !deployWait.ShouldWait() {
+		return
+	}
+
+	err = common.WaitForService(ctx, r.poller, r.client, service.Service, service.DeployID, deployWait.Until, updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service",
+			"Deploy never finished: "+err.Error(),
+		)
 		return
 	}
 }
 
 func (r *webServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state webservice.WebServiceModel
+	var state webservice.WebServiceResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := common.Delete(func() (*http.Response, error) {
 		return r.client.DeleteService(ctx, state.Id.ValueString())
 	})
@@ -281,8 +361,15 @@ func (r *webServiceResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *webServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is either the resource ID or a name reference such as
+	// name:my-api or env:<environment_id>/name:my-api.
+	common.ImportStateByName(ctx, req, resp, func(ref common.NameRef) (string, error) {
+		found, err := common.FindServiceByName(ctx, r.client, r.ownerID, client.WebService, ref)
+		if err != nil {
+			return "", err
+		}
+		return found.Id, nil
+	})
 }
 
 func (r *webServiceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {